@@ -10,6 +10,8 @@ import (
 	empty "github.com/golang/protobuf/ptypes/empty"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +23,179 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InsertResult is the outcome of inserting an element with InsertUnique.
+type InsertResult int32
+
+const (
+	// Never sent, so that an unset result is not read as a success.
+	InsertResult_INSERT_RESULT_UNSPECIFIED InsertResult = 0
+	// The element was inserted.
+	InsertResult_ADDED InsertResult = 1
+	// The filter already held the element, or a false positive of it, and
+	// was left unchanged.
+	InsertResult_ALREADY_PRESENT InsertResult = 2
+	// The element was absent and the filter had no room for it.
+	InsertResult_INSERT_FAILED InsertResult = 3
+)
+
+// Enum value maps for InsertResult.
+var (
+	InsertResult_name = map[int32]string{
+		0: "INSERT_RESULT_UNSPECIFIED",
+		1: "ADDED",
+		2: "ALREADY_PRESENT",
+		3: "INSERT_FAILED",
+	}
+	InsertResult_value = map[string]int32{
+		"INSERT_RESULT_UNSPECIFIED": 0,
+		"ADDED":                     1,
+		"ALREADY_PRESENT":           2,
+		"INSERT_FAILED":             3,
+	}
+)
+
+func (x InsertResult) Enum() *InsertResult {
+	p := new(InsertResult)
+	*p = x
+	return p
+}
+
+func (x InsertResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsertResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_cuckoofilter_cuckoofilter_proto_enumTypes[0].Descriptor()
+}
+
+func (InsertResult) Type() protoreflect.EnumType {
+	return &file_cuckoofilter_cuckoofilter_proto_enumTypes[0]
+}
+
+func (x InsertResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsertResult.Descriptor instead.
+func (InsertResult) EnumDescriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{0}
+}
+
+// DeleteResult is the outcome of deleting an element with DeleteElements.
+type DeleteResult int32
+
+const (
+	// Never sent, so that an unset result is not read as a success.
+	DeleteResult_DELETE_RESULT_UNSPECIFIED DeleteResult = 0
+	// One insertion of the element, or of a false positive of it, was
+	// removed.
+	DeleteResult_DELETED DeleteResult = 1
+	// The filter did not hold the element and was left unchanged.
+	DeleteResult_NOT_FOUND DeleteResult = 2
+)
+
+// Enum value maps for DeleteResult.
+var (
+	DeleteResult_name = map[int32]string{
+		0: "DELETE_RESULT_UNSPECIFIED",
+		1: "DELETED",
+		2: "NOT_FOUND",
+	}
+	DeleteResult_value = map[string]int32{
+		"DELETE_RESULT_UNSPECIFIED": 0,
+		"DELETED":                   1,
+		"NOT_FOUND":                 2,
+	}
+)
+
+func (x DeleteResult) Enum() *DeleteResult {
+	p := new(DeleteResult)
+	*p = x
+	return p
+}
+
+func (x DeleteResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_cuckoofilter_cuckoofilter_proto_enumTypes[1].Descriptor()
+}
+
+func (DeleteResult) Type() protoreflect.EnumType {
+	return &file_cuckoofilter_cuckoofilter_proto_enumTypes[1]
+}
+
+func (x DeleteResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteResult.Descriptor instead.
+func (DeleteResult) EnumDescriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{1}
+}
+
+// LookupResult is the outcome of looking up an element with
+// LookupElementsStream.
+type LookupResult int32
+
+const (
+	// Never sent, so that an unset result is not read as a success.
+	LookupResult_LOOKUP_RESULT_UNSPECIFIED LookupResult = 0
+	// The filter holds the element, or a false positive of it.
+	LookupResult_FOUND LookupResult = 1
+	// The filter does not hold the element.
+	LookupResult_ABSENT LookupResult = 2
+	// The request failed, for the reason in status.
+	LookupResult_LOOKUP_FAILED LookupResult = 3
+)
+
+// Enum value maps for LookupResult.
+var (
+	LookupResult_name = map[int32]string{
+		0: "LOOKUP_RESULT_UNSPECIFIED",
+		1: "FOUND",
+		2: "ABSENT",
+		3: "LOOKUP_FAILED",
+	}
+	LookupResult_value = map[string]int32{
+		"LOOKUP_RESULT_UNSPECIFIED": 0,
+		"FOUND":                     1,
+		"ABSENT":                    2,
+		"LOOKUP_FAILED":             3,
+	}
+)
+
+func (x LookupResult) Enum() *LookupResult {
+	p := new(LookupResult)
+	*p = x
+	return p
+}
+
+func (x LookupResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LookupResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_cuckoofilter_cuckoofilter_proto_enumTypes[2].Descriptor()
+}
+
+func (LookupResult) Type() protoreflect.EnumType {
+	return &file_cuckoofilter_cuckoofilter_proto_enumTypes[2]
+}
+
+func (x LookupResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LookupResult.Descriptor instead.
+func (LookupResult) EnumDescriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{2}
+}
+
+// Status is the legacy result carried in every response. Servers started
+// with the legacy status option report failures here with an OK gRPC status;
+// otherwise failures are returned as gRPC errors and Status only mirrors them.
 type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -76,19 +251,108 @@ func (x *Status) GetMsg() string {
 	return ""
 }
 
+// FailedElements is attached to RESOURCE_EXHAUSTED errors from InsertElements
+// as a google.rpc error detail.
+type FailedElements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elements    []string `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	RawElements [][]byte `protobuf:"bytes,2,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
+	// hashes lists the failed hashes of InsertHashes.
+	Hashes []uint64 `protobuf:"fixed64,3,rep,packed,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *FailedElements) Reset() {
+	*x = FailedElements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedElements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedElements) ProtoMessage() {}
+
+func (x *FailedElements) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedElements.ProtoReflect.Descriptor instead.
+func (*FailedElements) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FailedElements) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *FailedElements) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+func (x *FailedElements) GetHashes() []uint64 {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// CreateFilterRequest creates a filter sized for capacity elements. A filter
+// created with grow set never runs out of room: once it is full it chains a
+// new sub-filter growth_factor times larger (2 when unset, at most 16).
 type CreateFilterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
-	Capacity   uint64 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FilterName   string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Capacity     uint64 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Grow         bool   `protobuf:"varint,3,opt,name=grow,proto3" json:"grow,omitempty"`
+	GrowthFactor uint32 `protobuf:"varint,4,opt,name=growth_factor,json=growthFactor,proto3" json:"growth_factor,omitempty"`
+	// Either a target false positive rate or a fingerprint size; neither
+	// means 16 bit fingerprints.
+	FalsePositiveRate float64 `protobuf:"fixed64,5,opt,name=false_positive_rate,json=falsePositiveRate,proto3" json:"false_positive_rate,omitempty"`
+	FingerprintBits   uint32  `protobuf:"varint,6,opt,name=fingerprint_bits,json=fingerprintBits,proto3" json:"fingerprint_bits,omitempty"`
+	BucketSize        uint32  `protobuf:"varint,7,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	// A window makes a filter forget elements older than it, in
+	// window_slices steps (8 by default).
+	Window       *durationpb.Duration `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
+	WindowSlices uint32               `protobuf:"varint,9,opt,name=window_slices,json=windowSlices,proto3" json:"window_slices,omitempty"`
+	// A counting filter counts repeated inserts of an element, which
+	// CountElement reports, instead of storing it again.
+	Counting bool `protobuf:"varint,10,opt,name=counting,proto3" json:"counting,omitempty"`
+	// backend names the data structure behind the filter, "cuckoo" when
+	// unset.
+	Backend string `protobuf:"bytes,11,opt,name=backend,proto3" json:"backend,omitempty"`
+	// A filter created with value_bits, 1 to 16, stores a value of that
+	// many bits with each element, which GetElementValue returns.
+	ValueBits uint32 `protobuf:"varint,12,opt,name=value_bits,json=valueBits,proto3" json:"value_bits,omitempty"`
 }
 
 func (x *CreateFilterRequest) Reset() {
 	*x = CreateFilterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[1]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -101,7 +365,7 @@ func (x *CreateFilterRequest) String() string {
 func (*CreateFilterRequest) ProtoMessage() {}
 
 func (x *CreateFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[1]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +378,7 @@ func (x *CreateFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFilterRequest.ProtoReflect.Descriptor instead.
 func (*CreateFilterRequest) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{1}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateFilterRequest) GetFilterName() string {
@@ -131,6 +395,76 @@ func (x *CreateFilterRequest) GetCapacity() uint64 {
 	return 0
 }
 
+func (x *CreateFilterRequest) GetGrow() bool {
+	if x != nil {
+		return x.Grow
+	}
+	return false
+}
+
+func (x *CreateFilterRequest) GetGrowthFactor() uint32 {
+	if x != nil {
+		return x.GrowthFactor
+	}
+	return 0
+}
+
+func (x *CreateFilterRequest) GetFalsePositiveRate() float64 {
+	if x != nil {
+		return x.FalsePositiveRate
+	}
+	return 0
+}
+
+func (x *CreateFilterRequest) GetFingerprintBits() uint32 {
+	if x != nil {
+		return x.FingerprintBits
+	}
+	return 0
+}
+
+func (x *CreateFilterRequest) GetBucketSize() uint32 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+func (x *CreateFilterRequest) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *CreateFilterRequest) GetWindowSlices() uint32 {
+	if x != nil {
+		return x.WindowSlices
+	}
+	return 0
+}
+
+func (x *CreateFilterRequest) GetCounting() bool {
+	if x != nil {
+		return x.Counting
+	}
+	return false
+}
+
+func (x *CreateFilterRequest) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *CreateFilterRequest) GetValueBits() uint32 {
+	if x != nil {
+		return x.ValueBits
+	}
+	return 0
+}
+
 type CreateFilterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -142,7 +476,7 @@ type CreateFilterResponse struct {
 func (x *CreateFilterResponse) Reset() {
 	*x = CreateFilterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[2]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -155,7 +489,7 @@ func (x *CreateFilterResponse) String() string {
 func (*CreateFilterResponse) ProtoMessage() {}
 
 func (x *CreateFilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[2]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +502,7 @@ func (x *CreateFilterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFilterResponse.ProtoReflect.Descriptor instead.
 func (*CreateFilterResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{2}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateFilterResponse) GetStatus() *Status {
@@ -189,7 +523,7 @@ type DeleteFilterRequest struct {
 func (x *DeleteFilterRequest) Reset() {
 	*x = DeleteFilterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[3]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +536,7 @@ func (x *DeleteFilterRequest) String() string {
 func (*DeleteFilterRequest) ProtoMessage() {}
 
 func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[3]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +549,7 @@ func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFilterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFilterRequest) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{3}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DeleteFilterRequest) GetFilterName() string {
@@ -236,7 +570,7 @@ type DeleteFilterResponse struct {
 func (x *DeleteFilterResponse) Reset() {
 	*x = DeleteFilterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[4]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -249,7 +583,7 @@ func (x *DeleteFilterResponse) String() string {
 func (*DeleteFilterResponse) ProtoMessage() {}
 
 func (x *DeleteFilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[4]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +596,7 @@ func (x *DeleteFilterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFilterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFilterResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{4}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteFilterResponse) GetStatus() *Status {
@@ -284,7 +618,7 @@ type ListFiltersResponse struct {
 func (x *ListFiltersResponse) Reset() {
 	*x = ListFiltersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[5]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +631,7 @@ func (x *ListFiltersResponse) String() string {
 func (*ListFiltersResponse) ProtoMessage() {}
 
 func (x *ListFiltersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[5]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +644,7 @@ func (x *ListFiltersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFiltersResponse.ProtoReflect.Descriptor instead.
 func (*ListFiltersResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{5}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListFiltersResponse) GetStatus() *Status {
@@ -334,12 +668,22 @@ type InsertElementRequest struct {
 
 	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
 	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	// The element is treated as absent once ttl has passed; unset means
+	// never. Expiries are rounded up to the minute.
+	Ttl *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// The value to store with the element in a filter created with
+	// value_bits. Inserting an element again replaces its value.
+	Value uint32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// raw_element sets the element as bytes instead, for keys such as
+	// digests that are not valid UTF-8. An element hashes the same either
+	// way; setting both fails with INVALID_ELEMENT.
+	RawElement []byte `protobuf:"bytes,5,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
 }
 
 func (x *InsertElementRequest) Reset() {
 	*x = InsertElementRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[6]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -352,7 +696,7 @@ func (x *InsertElementRequest) String() string {
 func (*InsertElementRequest) ProtoMessage() {}
 
 func (x *InsertElementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[6]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +709,7 @@ func (x *InsertElementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertElementRequest.ProtoReflect.Descriptor instead.
 func (*InsertElementRequest) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{6}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InsertElementRequest) GetFilterName() string {
@@ -382,6 +726,27 @@ func (x *InsertElementRequest) GetElement() string {
 	return ""
 }
 
+func (x *InsertElementRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *InsertElementRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *InsertElementRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
 type InsertElementResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,7 +758,7 @@ type InsertElementResponse struct {
 func (x *InsertElementResponse) Reset() {
 	*x = InsertElementResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[7]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +771,7 @@ func (x *InsertElementResponse) String() string {
 func (*InsertElementResponse) ProtoMessage() {}
 
 func (x *InsertElementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[7]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +784,7 @@ func (x *InsertElementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertElementResponse.ProtoReflect.Descriptor instead.
 func (*InsertElementResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{7}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InsertElementResponse) GetStatus() *Status {
@@ -436,12 +801,20 @@ type InsertElementsRequest struct {
 
 	FilterName string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
 	Elements   []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	// The ttl of every element, as in InsertElementRequest.
+	Ttl *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// The value of each element, as in InsertElementRequest, or none to
+	// store zero with every element.
+	Values []uint32 `protobuf:"varint,4,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// raw_elements are elements sent as bytes. They follow elements, so
+	// values lists the values of elements first.
+	RawElements [][]byte `protobuf:"bytes,5,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
 }
 
 func (x *InsertElementsRequest) Reset() {
 	*x = InsertElementsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[8]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -454,7 +827,7 @@ func (x *InsertElementsRequest) String() string {
 func (*InsertElementsRequest) ProtoMessage() {}
 
 func (x *InsertElementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[8]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +840,7 @@ func (x *InsertElementsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertElementsRequest.ProtoReflect.Descriptor instead.
 func (*InsertElementsRequest) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{8}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InsertElementsRequest) GetFilterName() string {
@@ -484,19 +857,42 @@ func (x *InsertElementsRequest) GetElements() []string {
 	return nil
 }
 
+func (x *InsertElementsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *InsertElementsRequest) GetValues() []uint32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *InsertElementsRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// Failed elements are returned in the form they were sent.
 type InsertElementsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status         *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FailedElements []string `protobuf:"bytes,2,rep,name=failed_elements,json=failedElements,proto3" json:"failed_elements,omitempty"`
+	Status            *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FailedElements    []string `protobuf:"bytes,2,rep,name=failed_elements,json=failedElements,proto3" json:"failed_elements,omitempty"`
+	RawFailedElements [][]byte `protobuf:"bytes,3,rep,name=raw_failed_elements,json=rawFailedElements,proto3" json:"raw_failed_elements,omitempty"`
 }
 
 func (x *InsertElementsResponse) Reset() {
 	*x = InsertElementsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[9]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -509,7 +905,7 @@ func (x *InsertElementsResponse) String() string {
 func (*InsertElementsResponse) ProtoMessage() {}
 
 func (x *InsertElementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[9]
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +918,7 @@ func (x *InsertElementsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertElementsResponse.ProtoReflect.Descriptor instead.
 func (*InsertElementsResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{9}
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InsertElementsResponse) GetStatus() *Status {
@@ -539,32 +935,49 @@ func (x *InsertElementsResponse) GetFailedElements() []string {
 	return nil
 }
 
-type DeleteElementRequest struct {
+func (x *InsertElementsResponse) GetRawFailedElements() [][]byte {
+	if x != nil {
+		return x.RawFailedElements
+	}
+	return nil
+}
+
+// InsertElementsStreamRequest carries one element of an InsertElementsStream,
+// with a sequence number the client chooses to match failures to elements.
+// The first request names the filter and may set the ttl of every element and
+// ack_window, the number of elements acknowledged by each response, up to
+// 5000 (1000 when unset); those fields are ignored on later requests.
+type InsertElementsStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
-	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	FilterName string               `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Sequence   uint64               `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Element    string               `protobuf:"bytes,3,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement []byte               `protobuf:"bytes,4,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+	Value      uint32               `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	Ttl        *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	AckWindow  uint32               `protobuf:"varint,7,opt,name=ack_window,json=ackWindow,proto3" json:"ack_window,omitempty"`
 }
 
-func (x *DeleteElementRequest) Reset() {
-	*x = DeleteElementRequest{}
+func (x *InsertElementsStreamRequest) Reset() {
+	*x = InsertElementsStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[10]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteElementRequest) String() string {
+func (x *InsertElementsStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteElementRequest) ProtoMessage() {}
+func (*InsertElementsStreamRequest) ProtoMessage() {}
 
-func (x *DeleteElementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[10]
+func (x *InsertElementsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,82 +988,76 @@ func (x *DeleteElementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteElementRequest.ProtoReflect.Descriptor instead.
-func (*DeleteElementRequest) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use InsertElementsStreamRequest.ProtoReflect.Descriptor instead.
+func (*InsertElementsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteElementRequest) GetFilterName() string {
+func (x *InsertElementsStreamRequest) GetFilterName() string {
 	if x != nil {
 		return x.FilterName
 	}
 	return ""
 }
 
-func (x *DeleteElementRequest) GetElement() string {
+func (x *InsertElementsStreamRequest) GetSequence() uint64 {
 	if x != nil {
-		return x.Element
+		return x.Sequence
 	}
-	return ""
-}
-
-type DeleteElementResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	return 0
 }
 
-func (x *DeleteElementResponse) Reset() {
-	*x = DeleteElementResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InsertElementsStreamRequest) GetElement() string {
+	if x != nil {
+		return x.Element
 	}
+	return ""
 }
 
-func (x *DeleteElementResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InsertElementsStreamRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
 }
 
-func (*DeleteElementResponse) ProtoMessage() {}
-
-func (x *DeleteElementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InsertElementsStreamRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteElementResponse.ProtoReflect.Descriptor instead.
-func (*DeleteElementResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{11}
+func (x *InsertElementsStreamRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
 }
 
-func (x *DeleteElementResponse) GetStatus() *Status {
+func (x *InsertElementsStreamRequest) GetAckWindow() uint32 {
 	if x != nil {
-		return x.Status
+		return x.AckWindow
 	}
-	return nil
+	return 0
 }
 
-type CountElementsRequest struct {
+// InsertElementsStreamResponse acknowledges the count elements received since
+// the previous response, the last of which had last_sequence. Every element
+// acknowledged and not listed in failures was inserted.
+type InsertElementsStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Status       *Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LastSequence uint64           `protobuf:"varint,2,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	Count        uint64           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Failures     []*InsertFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (x *CountElementsRequest) Reset() {
-	*x = CountElementsRequest{}
+func (x *InsertElementsStreamResponse) Reset() {
+	*x = InsertElementsStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -658,13 +1065,13 @@ func (x *CountElementsRequest) Reset() {
 	}
 }
 
-func (x *CountElementsRequest) String() string {
+func (x *InsertElementsStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountElementsRequest) ProtoMessage() {}
+func (*InsertElementsStreamResponse) ProtoMessage() {}
 
-func (x *CountElementsRequest) ProtoReflect() protoreflect.Message {
+func (x *InsertElementsStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,29 +1083,52 @@ func (x *CountElementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountElementsRequest.ProtoReflect.Descriptor instead.
-func (*CountElementsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertElementsStreamResponse.ProtoReflect.Descriptor instead.
+func (*InsertElementsStreamResponse) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CountElementsRequest) GetFilterName() string {
+func (x *InsertElementsStreamResponse) GetStatus() *Status {
 	if x != nil {
-		return x.FilterName
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type CountElementsResponse struct {
+func (x *InsertElementsStreamResponse) GetLastSequence() uint64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *InsertElementsStreamResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *InsertElementsStreamResponse) GetFailures() []*InsertFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// InsertFailure is an element of an InsertElementsStream that was not
+// inserted, with the status an InsertElement of it would have returned.
+type InsertFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Len    uint64  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+	Sequence uint64  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CountElementsResponse) Reset() {
-	*x = CountElementsResponse{}
+func (x *InsertFailure) Reset() {
+	*x = InsertFailure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,13 +1136,13 @@ func (x *CountElementsResponse) Reset() {
 	}
 }
 
-func (x *CountElementsResponse) String() string {
+func (x *InsertFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountElementsResponse) ProtoMessage() {}
+func (*InsertFailure) ProtoMessage() {}
 
-func (x *CountElementsResponse) ProtoReflect() protoreflect.Message {
+func (x *InsertFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,35 +1154,42 @@ func (x *CountElementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountElementsResponse.ProtoReflect.Descriptor instead.
-func (*CountElementsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertFailure.ProtoReflect.Descriptor instead.
+func (*InsertFailure) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CountElementsResponse) GetStatus() *Status {
+func (x *InsertFailure) GetSequence() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *CountElementsResponse) GetLen() uint64 {
+func (x *InsertFailure) GetStatus() *Status {
 	if x != nil {
-		return x.Len
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-type ResetFilterRequest struct {
+// InsertUniqueRequest inserts an element unless the filter already holds it,
+// checking and inserting under the filter lock. The ttl and value only apply
+// when the element is added.
+type InsertUniqueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	FilterName string               `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element    string               `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	Ttl        *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value      uint32               `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	RawElement []byte               `protobuf:"bytes,5,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
 }
 
-func (x *ResetFilterRequest) Reset() {
-	*x = ResetFilterRequest{}
+func (x *InsertUniqueRequest) Reset() {
+	*x = InsertUniqueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -760,13 +1197,13 @@ func (x *ResetFilterRequest) Reset() {
 	}
 }
 
-func (x *ResetFilterRequest) String() string {
+func (x *InsertUniqueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetFilterRequest) ProtoMessage() {}
+func (*InsertUniqueRequest) ProtoMessage() {}
 
-func (x *ResetFilterRequest) ProtoReflect() protoreflect.Message {
+func (x *InsertUniqueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,28 +1215,57 @@ func (x *ResetFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetFilterRequest.ProtoReflect.Descriptor instead.
-func (*ResetFilterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertUniqueRequest.ProtoReflect.Descriptor instead.
+func (*InsertUniqueRequest) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ResetFilterRequest) GetFilterName() string {
+func (x *InsertUniqueRequest) GetFilterName() string {
 	if x != nil {
 		return x.FilterName
 	}
 	return ""
 }
 
-type ResetFilterResponse struct {
+func (x *InsertUniqueRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *InsertUniqueRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *InsertUniqueRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *InsertUniqueRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+type InsertUniqueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Result InsertResult `protobuf:"varint,2,opt,name=result,proto3,enum=cuckoofilter.InsertResult" json:"result,omitempty"`
 }
 
-func (x *ResetFilterResponse) Reset() {
-	*x = ResetFilterResponse{}
+func (x *InsertUniqueResponse) Reset() {
+	*x = InsertUniqueResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,13 +1273,13 @@ func (x *ResetFilterResponse) Reset() {
 	}
 }
 
-func (x *ResetFilterResponse) String() string {
+func (x *InsertUniqueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetFilterResponse) ProtoMessage() {}
+func (*InsertUniqueResponse) ProtoMessage() {}
 
-func (x *ResetFilterResponse) ProtoReflect() protoreflect.Message {
+func (x *InsertUniqueResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -825,29 +1291,41 @@ func (x *ResetFilterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetFilterResponse.ProtoReflect.Descriptor instead.
-func (*ResetFilterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertUniqueResponse.ProtoReflect.Descriptor instead.
+func (*InsertUniqueResponse) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResetFilterResponse) GetStatus() *Status {
+func (x *InsertUniqueResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type LookupElementRequest struct {
+func (x *InsertUniqueResponse) GetResult() InsertResult {
+	if x != nil {
+		return x.Result
+	}
+	return InsertResult_INSERT_RESULT_UNSPECIFIED
+}
+
+// InsertUniqueBatchRequest inserts each element as InsertUnique does, in
+// order, so that an element repeated in the batch is added once.
+type InsertUniqueBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
-	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	FilterName  string               `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Elements    []string             `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Ttl         *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Values      []uint32             `protobuf:"varint,4,rep,packed,name=values,proto3" json:"values,omitempty"`
+	RawElements [][]byte             `protobuf:"bytes,5,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
 }
 
-func (x *LookupElementRequest) Reset() {
-	*x = LookupElementRequest{}
+func (x *InsertUniqueBatchRequest) Reset() {
+	*x = InsertUniqueBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,13 +1333,13 @@ func (x *LookupElementRequest) Reset() {
 	}
 }
 
-func (x *LookupElementRequest) String() string {
+func (x *InsertUniqueBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementRequest) ProtoMessage() {}
+func (*InsertUniqueBatchRequest) ProtoMessage() {}
 
-func (x *LookupElementRequest) ProtoReflect() protoreflect.Message {
+func (x *InsertUniqueBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -873,35 +1351,60 @@ func (x *LookupElementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementRequest.ProtoReflect.Descriptor instead.
-func (*LookupElementRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertUniqueBatchRequest.ProtoReflect.Descriptor instead.
+func (*InsertUniqueBatchRequest) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *LookupElementRequest) GetFilterName() string {
+func (x *InsertUniqueBatchRequest) GetFilterName() string {
 	if x != nil {
 		return x.FilterName
 	}
 	return ""
 }
 
-func (x *LookupElementRequest) GetElement() string {
+func (x *InsertUniqueBatchRequest) GetElements() []string {
 	if x != nil {
-		return x.Element
+		return x.Elements
 	}
-	return ""
+	return nil
 }
 
-type LookupElementResponse struct {
+func (x *InsertUniqueBatchRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *InsertUniqueBatchRequest) GetValues() []uint32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *InsertUniqueBatchRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// InsertUniqueBatchResponse holds the result of each element, in order,
+// raw elements last.
+// Elements that fail do not fail the request.
+type InsertUniqueBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status  *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []InsertResult `protobuf:"varint,2,rep,packed,name=results,proto3,enum=cuckoofilter.InsertResult" json:"results,omitempty"`
 }
 
-func (x *LookupElementResponse) Reset() {
-	*x = LookupElementResponse{}
+func (x *InsertUniqueBatchResponse) Reset() {
+	*x = InsertUniqueBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +1412,13 @@ func (x *LookupElementResponse) Reset() {
 	}
 }
 
-func (x *LookupElementResponse) String() string {
+func (x *InsertUniqueBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementResponse) ProtoMessage() {}
+func (*InsertUniqueBatchResponse) ProtoMessage() {}
 
-func (x *LookupElementResponse) ProtoReflect() protoreflect.Message {
+func (x *InsertUniqueBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,29 +1430,44 @@ func (x *LookupElementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementResponse.ProtoReflect.Descriptor instead.
-func (*LookupElementResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertUniqueBatchResponse.ProtoReflect.Descriptor instead.
+func (*InsertUniqueBatchResponse) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *LookupElementResponse) GetStatus() *Status {
+func (x *InsertUniqueBatchResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type LookupElementsRequest struct {
+func (x *InsertUniqueBatchResponse) GetResults() []InsertResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// InsertHashesRequest inserts up to 5000 elements given by their hashes
+// rather than the elements themselves. hash_version names the version of the
+// hash the client computed; the server only takes the version it implements,
+// 1, and hashes the elements of other requests the same way. Filters whose
+// backend hashes elements differently, bloom filters, do not take hashes.
+type InsertHashesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
-	Elements   []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	FilterName  string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Hashes      []uint64 `protobuf:"fixed64,2,rep,packed,name=hashes,proto3" json:"hashes,omitempty"`
+	HashVersion uint32   `protobuf:"varint,3,opt,name=hash_version,json=hashVersion,proto3" json:"hash_version,omitempty"`
+	// The ttl of every element, as in InsertElementRequest.
+	Ttl *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *LookupElementsRequest) Reset() {
-	*x = LookupElementsRequest{}
+func (x *InsertHashesRequest) Reset() {
+	*x = InsertHashesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -957,13 +1475,13 @@ func (x *LookupElementsRequest) Reset() {
 	}
 }
 
-func (x *LookupElementsRequest) String() string {
+func (x *InsertHashesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementsRequest) ProtoMessage() {}
+func (*InsertHashesRequest) ProtoMessage() {}
 
-func (x *LookupElementsRequest) ProtoReflect() protoreflect.Message {
+func (x *InsertHashesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,37 +1493,50 @@ func (x *LookupElementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementsRequest.ProtoReflect.Descriptor instead.
-func (*LookupElementsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertHashesRequest.ProtoReflect.Descriptor instead.
+func (*InsertHashesRequest) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *LookupElementsRequest) GetFilterName() string {
+func (x *InsertHashesRequest) GetFilterName() string {
 	if x != nil {
 		return x.FilterName
 	}
 	return ""
 }
 
-func (x *LookupElementsRequest) GetElements() []string {
+func (x *InsertHashesRequest) GetHashes() []uint64 {
 	if x != nil {
-		return x.Elements
+		return x.Hashes
 	}
 	return nil
 }
 
-type LookupElementsResponse struct {
+func (x *InsertHashesRequest) GetHashVersion() uint32 {
+	if x != nil {
+		return x.HashVersion
+	}
+	return 0
+}
+
+func (x *InsertHashesRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type InsertHashesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status            *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	MatchedElements   []string `protobuf:"bytes,2,rep,name=matched_elements,json=matchedElements,proto3" json:"matched_elements,omitempty"`
-	UnmatchedElements []string `protobuf:"bytes,3,rep,name=unmatched_elements,json=unmatchedElements,proto3" json:"unmatched_elements,omitempty"`
+	Status       *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FailedHashes []uint64 `protobuf:"fixed64,2,rep,packed,name=failed_hashes,json=failedHashes,proto3" json:"failed_hashes,omitempty"`
 }
 
-func (x *LookupElementsResponse) Reset() {
-	*x = LookupElementsResponse{}
+func (x *InsertHashesResponse) Reset() {
+	*x = InsertHashesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,13 +1544,13 @@ func (x *LookupElementsResponse) Reset() {
 	}
 }
 
-func (x *LookupElementsResponse) String() string {
+func (x *InsertHashesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementsResponse) ProtoMessage() {}
+func (*InsertHashesResponse) ProtoMessage() {}
 
-func (x *LookupElementsResponse) ProtoReflect() protoreflect.Message {
+func (x *InsertHashesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,43 +1562,39 @@ func (x *LookupElementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementsResponse.ProtoReflect.Descriptor instead.
-func (*LookupElementsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertHashesResponse.ProtoReflect.Descriptor instead.
+func (*InsertHashesResponse) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LookupElementsResponse) GetStatus() *Status {
+func (x *InsertHashesResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *LookupElementsResponse) GetMatchedElements() []string {
-	if x != nil {
-		return x.MatchedElements
-	}
-	return nil
-}
-
-func (x *LookupElementsResponse) GetUnmatchedElements() []string {
+func (x *InsertHashesResponse) GetFailedHashes() []uint64 {
 	if x != nil {
-		return x.UnmatchedElements
+		return x.FailedHashes
 	}
 	return nil
 }
 
-type LookupElementsStreamRequest struct {
+// LookupHashesRequest looks up up to 5000 elements given by their hashes, as
+// in InsertHashesRequest.
+type LookupHashesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
-	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	FilterName  string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Hashes      []uint64 `protobuf:"fixed64,2,rep,packed,name=hashes,proto3" json:"hashes,omitempty"`
+	HashVersion uint32   `protobuf:"varint,3,opt,name=hash_version,json=hashVersion,proto3" json:"hash_version,omitempty"`
 }
 
-func (x *LookupElementsStreamRequest) Reset() {
-	*x = LookupElementsStreamRequest{}
+func (x *LookupHashesRequest) Reset() {
+	*x = LookupHashesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,13 +1602,13 @@ func (x *LookupElementsStreamRequest) Reset() {
 	}
 }
 
-func (x *LookupElementsStreamRequest) String() string {
+func (x *LookupHashesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementsStreamRequest) ProtoMessage() {}
+func (*LookupHashesRequest) ProtoMessage() {}
 
-func (x *LookupElementsStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *LookupHashesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1093,50 +1620,2129 @@ func (x *LookupElementsStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementsStreamRequest.ProtoReflect.Descriptor instead.
-func (*LookupElementsStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LookupHashesRequest.ProtoReflect.Descriptor instead.
+func (*LookupHashesRequest) Descriptor() ([]byte, []int) {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *LookupElementsStreamRequest) GetFilterName() string {
+func (x *LookupHashesRequest) GetFilterName() string {
 	if x != nil {
 		return x.FilterName
 	}
 	return ""
 }
 
-func (x *LookupElementsStreamRequest) GetElement() string {
+func (x *LookupHashesRequest) GetHashes() []uint64 {
 	if x != nil {
-		return x.Element
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *LookupHashesRequest) GetHashVersion() uint32 {
+	if x != nil {
+		return x.HashVersion
+	}
+	return 0
+}
+
+type LookupHashesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MatchedHashes   []uint64 `protobuf:"fixed64,2,rep,packed,name=matched_hashes,json=matchedHashes,proto3" json:"matched_hashes,omitempty"`
+	UnmatchedHashes []uint64 `protobuf:"fixed64,3,rep,packed,name=unmatched_hashes,json=unmatchedHashes,proto3" json:"unmatched_hashes,omitempty"`
+}
+
+func (x *LookupHashesResponse) Reset() {
+	*x = LookupHashesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupHashesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupHashesResponse) ProtoMessage() {}
+
+func (x *LookupHashesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupHashesResponse.ProtoReflect.Descriptor instead.
+func (*LookupHashesResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LookupHashesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LookupHashesResponse) GetMatchedHashes() []uint64 {
+	if x != nil {
+		return x.MatchedHashes
+	}
+	return nil
+}
+
+func (x *LookupHashesResponse) GetUnmatchedHashes() []uint64 {
+	if x != nil {
+		return x.UnmatchedHashes
+	}
+	return nil
+}
+
+type DeleteElementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement []byte `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+}
+
+func (x *DeleteElementRequest) Reset() {
+	*x = DeleteElementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteElementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteElementRequest) ProtoMessage() {}
+
+func (x *DeleteElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteElementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteElementRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteElementRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *DeleteElementRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *DeleteElementRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+type DeleteElementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteElementResponse) Reset() {
+	*x = DeleteElementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteElementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteElementResponse) ProtoMessage() {}
+
+func (x *DeleteElementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteElementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteElementResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteElementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// DeleteElementsRequest deletes up to 5000 elements, in order, so that an
+// element repeated in the batch is deleted as many times. In
+// DeleteElementsStream each request is a batch answered by one response.
+type DeleteElementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName  string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Elements    []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	RawElements [][]byte `protobuf:"bytes,3,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
+}
+
+func (x *DeleteElementsRequest) Reset() {
+	*x = DeleteElementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteElementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteElementsRequest) ProtoMessage() {}
+
+func (x *DeleteElementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteElementsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteElementsRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteElementsRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *DeleteElementsRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *DeleteElementsRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// DeleteElementsResponse holds the result of each element, in order, raw
+// elements last. Elements that are not found do not fail the request.
+type DeleteElementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []DeleteResult `protobuf:"varint,2,rep,packed,name=results,proto3,enum=cuckoofilter.DeleteResult" json:"results,omitempty"`
+}
+
+func (x *DeleteElementsResponse) Reset() {
+	*x = DeleteElementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteElementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteElementsResponse) ProtoMessage() {}
+
+func (x *DeleteElementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteElementsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteElementsResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteElementsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DeleteElementsResponse) GetResults() []DeleteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CountElementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+}
+
+func (x *CountElementsRequest) Reset() {
+	*x = CountElementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountElementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountElementsRequest) ProtoMessage() {}
+
+func (x *CountElementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountElementsRequest.ProtoReflect.Descriptor instead.
+func (*CountElementsRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CountElementsRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+type CountElementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Len    uint64  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (x *CountElementsResponse) Reset() {
+	*x = CountElementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountElementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountElementsResponse) ProtoMessage() {}
+
+func (x *CountElementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountElementsResponse.ProtoReflect.Descriptor instead.
+func (*CountElementsResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CountElementsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CountElementsResponse) GetLen() uint64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+// CountElement returns about how many times an element was inserted and not
+// deleted. Filters created without counting count at most twice the bucket
+// size; false positives can add to the count.
+type CountElementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement []byte `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+}
+
+func (x *CountElementRequest) Reset() {
+	*x = CountElementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountElementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountElementRequest) ProtoMessage() {}
+
+func (x *CountElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountElementRequest.ProtoReflect.Descriptor instead.
+func (*CountElementRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CountElementRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *CountElementRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *CountElementRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+type CountElementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count  uint64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountElementResponse) Reset() {
+	*x = CountElementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountElementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountElementResponse) ProtoMessage() {}
+
+func (x *CountElementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountElementResponse.ProtoReflect.Descriptor instead.
+func (*CountElementResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CountElementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CountElementResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetElementValue returns the value stored with an element. found is false
+// when the filter does not hold the element; a false positive returns the
+// value of another element.
+type GetElementValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement []byte `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+}
+
+func (x *GetElementValueRequest) Reset() {
+	*x = GetElementValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElementValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElementValueRequest) ProtoMessage() {}
+
+func (x *GetElementValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElementValueRequest.ProtoReflect.Descriptor instead.
+func (*GetElementValueRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetElementValueRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *GetElementValueRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *GetElementValueRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+type GetElementValueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Found  bool    `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Value  uint32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetElementValueResponse) Reset() {
+	*x = GetElementValueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElementValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElementValueResponse) ProtoMessage() {}
+
+func (x *GetElementValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElementValueResponse.ProtoReflect.Descriptor instead.
+func (*GetElementValueResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetElementValueResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetElementValueResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetElementValueResponse) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type CountEachElementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName  string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Elements    []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	RawElements [][]byte `protobuf:"bytes,3,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
+}
+
+func (x *CountEachElementRequest) Reset() {
+	*x = CountEachElementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountEachElementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEachElementRequest) ProtoMessage() {}
+
+func (x *CountEachElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEachElementRequest.ProtoReflect.Descriptor instead.
+func (*CountEachElementRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CountEachElementRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *CountEachElementRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *CountEachElementRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// counts holds the count of each requested element, in order, raw elements
+// last.
+type CountEachElementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Counts []uint64 `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *CountEachElementResponse) Reset() {
+	*x = CountEachElementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountEachElementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEachElementResponse) ProtoMessage() {}
+
+func (x *CountEachElementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEachElementResponse.ProtoReflect.Descriptor instead.
+func (*CountEachElementResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CountEachElementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CountEachElementResponse) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type ResetFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+}
+
+func (x *ResetFilterRequest) Reset() {
+	*x = ResetFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetFilterRequest) ProtoMessage() {}
+
+func (x *ResetFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetFilterRequest.ProtoReflect.Descriptor instead.
+func (*ResetFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResetFilterRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+type ResetFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResetFilterResponse) Reset() {
+	*x = ResetFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetFilterResponse) ProtoMessage() {}
+
+func (x *ResetFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetFilterResponse.ProtoReflect.Descriptor instead.
+func (*ResetFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResetFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type LookupElementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element    string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement []byte `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+}
+
+func (x *LookupElementRequest) Reset() {
+	*x = LookupElementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementRequest) ProtoMessage() {}
+
+func (x *LookupElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementRequest.ProtoReflect.Descriptor instead.
+func (*LookupElementRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LookupElementRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *LookupElementRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *LookupElementRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+type LookupElementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Found  bool    `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *LookupElementResponse) Reset() {
+	*x = LookupElementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementResponse) ProtoMessage() {}
+
+func (x *LookupElementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementResponse.ProtoReflect.Descriptor instead.
+func (*LookupElementResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LookupElementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LookupElementResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type LookupElementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName  string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Elements    []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	RawElements [][]byte `protobuf:"bytes,3,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
+}
+
+func (x *LookupElementsRequest) Reset() {
+	*x = LookupElementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementsRequest) ProtoMessage() {}
+
+func (x *LookupElementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementsRequest.ProtoReflect.Descriptor instead.
+func (*LookupElementsRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LookupElementsRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *LookupElementsRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *LookupElementsRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// Elements are returned in the form they were sent.
+type LookupElementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status               *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MatchedElements      []string `protobuf:"bytes,2,rep,name=matched_elements,json=matchedElements,proto3" json:"matched_elements,omitempty"`
+	UnmatchedElements    []string `protobuf:"bytes,3,rep,name=unmatched_elements,json=unmatchedElements,proto3" json:"unmatched_elements,omitempty"`
+	RawMatchedElements   [][]byte `protobuf:"bytes,4,rep,name=raw_matched_elements,json=rawMatchedElements,proto3" json:"raw_matched_elements,omitempty"`
+	RawUnmatchedElements [][]byte `protobuf:"bytes,5,rep,name=raw_unmatched_elements,json=rawUnmatchedElements,proto3" json:"raw_unmatched_elements,omitempty"`
+}
+
+func (x *LookupElementsResponse) Reset() {
+	*x = LookupElementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementsResponse) ProtoMessage() {}
+
+func (x *LookupElementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementsResponse.ProtoReflect.Descriptor instead.
+func (*LookupElementsResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LookupElementsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LookupElementsResponse) GetMatchedElements() []string {
+	if x != nil {
+		return x.MatchedElements
+	}
+	return nil
+}
+
+func (x *LookupElementsResponse) GetUnmatchedElements() []string {
+	if x != nil {
+		return x.UnmatchedElements
+	}
+	return nil
+}
+
+func (x *LookupElementsResponse) GetRawMatchedElements() [][]byte {
+	if x != nil {
+		return x.RawMatchedElements
+	}
+	return nil
+}
+
+func (x *LookupElementsResponse) GetRawUnmatchedElements() [][]byte {
+	if x != nil {
+		return x.RawUnmatchedElements
+	}
+	return nil
+}
+
+// LookupElementsStreamRequest looks up one element. correlation_id is chosen
+// by the client and returned in the response to the request.
+type LookupElementsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName    string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Element       string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement    []byte `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+	CorrelationId uint64 `protobuf:"varint,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *LookupElementsStreamRequest) Reset() {
+	*x = LookupElementsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementsStreamRequest) ProtoMessage() {}
+
+func (x *LookupElementsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementsStreamRequest.ProtoReflect.Descriptor instead.
+func (*LookupElementsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LookupElementsStreamRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *LookupElementsStreamRequest) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *LookupElementsStreamRequest) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+func (x *LookupElementsStreamRequest) GetCorrelationId() uint64 {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return 0
+}
+
+// LookupElementsStreamResponse answers one request, in the order they were
+// received, echoing its element and correlation_id. status is OK unless the
+// request failed, as for a filter that does not exist.
+type LookupElementsStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Element       string       `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	RawElement    []byte       `protobuf:"bytes,3,opt,name=raw_element,json=rawElement,proto3" json:"raw_element,omitempty"`
+	CorrelationId uint64       `protobuf:"varint,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Result        LookupResult `protobuf:"varint,5,opt,name=result,proto3,enum=cuckoofilter.LookupResult" json:"result,omitempty"`
+}
+
+func (x *LookupElementsStreamResponse) Reset() {
+	*x = LookupElementsStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupElementsStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupElementsStreamResponse) ProtoMessage() {}
+
+func (x *LookupElementsStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupElementsStreamResponse.ProtoReflect.Descriptor instead.
+func (*LookupElementsStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LookupElementsStreamResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LookupElementsStreamResponse) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *LookupElementsStreamResponse) GetRawElement() []byte {
+	if x != nil {
+		return x.RawElement
+	}
+	return nil
+}
+
+func (x *LookupElementsStreamResponse) GetCorrelationId() uint64 {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return 0
+}
+
+func (x *LookupElementsStreamResponse) GetResult() LookupResult {
+	if x != nil {
+		return x.Result
+	}
+	return LookupResult_LOOKUP_RESULT_UNSPECIFIED
+}
+
+type ExportFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+}
+
+func (x *ExportFilterRequest) Reset() {
+	*x = ExportFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFilterRequest) ProtoMessage() {}
+
+func (x *ExportFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFilterRequest.ProtoReflect.Descriptor instead.
+func (*ExportFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportFilterRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+// ExportFilterResponse carries one chunk of the serialized filter. The first
+// response also carries the size and hex SHA-256 of the whole serialization.
+type ExportFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Size   uint64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string  `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Chunk  []byte  `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportFilterResponse) Reset() {
+	*x = ExportFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFilterResponse) ProtoMessage() {}
+
+func (x *ExportFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFilterResponse.ProtoReflect.Descriptor instead.
+func (*ExportFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExportFilterResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExportFilterResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ExportFilterResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportFilterRequest carries one chunk of a filter serialized by
+// ExportFilter. The first request names the filter and gives the size and hex
+// SHA-256 of the whole serialization; the other fields are ignored on later
+// requests. With replace set an existing filter of that name is replaced.
+type ImportFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Size       uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256     string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Chunk      []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Replace    bool   `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *ImportFilterRequest) Reset() {
+	*x = ImportFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFilterRequest) ProtoMessage() {}
+
+func (x *ImportFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFilterRequest.ProtoReflect.Descriptor instead.
+func (*ImportFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportFilterRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *ImportFilterRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImportFilterRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ImportFilterRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ImportFilterRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type ImportFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ImportFilterResponse) Reset() {
+	*x = ImportFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFilterResponse) ProtoMessage() {}
+
+func (x *ImportFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFilterResponse.ProtoReflect.Descriptor instead.
+func (*ImportFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// BuildStaticFilterRequest carries a batch of up to 5000 elements of a static
+// filter. The first request names the filter and may set fingerprint_bits, 8
+// or 16 (16 when unset), and replace; those fields are ignored on later
+// requests. With replace set an existing filter of that name is replaced.
+type BuildStaticFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName      string   `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+	Elements        []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	FingerprintBits uint32   `protobuf:"varint,3,opt,name=fingerprint_bits,json=fingerprintBits,proto3" json:"fingerprint_bits,omitempty"`
+	Replace         bool     `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
+	RawElements     [][]byte `protobuf:"bytes,5,rep,name=raw_elements,json=rawElements,proto3" json:"raw_elements,omitempty"`
+}
+
+func (x *BuildStaticFilterRequest) Reset() {
+	*x = BuildStaticFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildStaticFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildStaticFilterRequest) ProtoMessage() {}
+
+func (x *BuildStaticFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStaticFilterRequest.ProtoReflect.Descriptor instead.
+func (*BuildStaticFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BuildStaticFilterRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
+	}
+	return ""
+}
+
+func (x *BuildStaticFilterRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *BuildStaticFilterRequest) GetFingerprintBits() uint32 {
+	if x != nil {
+		return x.FingerprintBits
+	}
+	return 0
+}
+
+func (x *BuildStaticFilterRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *BuildStaticFilterRequest) GetRawElements() [][]byte {
+	if x != nil {
+		return x.RawElements
+	}
+	return nil
+}
+
+// BuildStaticFilterResponse reports the number of distinct elements the
+// filter was built from.
+type BuildStaticFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count  uint64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BuildStaticFilterResponse) Reset() {
+	*x = BuildStaticFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildStaticFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildStaticFilterResponse) ProtoMessage() {}
+
+func (x *BuildStaticFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStaticFilterResponse.ProtoReflect.Descriptor instead.
+func (*BuildStaticFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BuildStaticFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *BuildStaticFilterResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// FilterInfo describes a filter. capacity is zero for filters restored from
+// snapshots that did not record it, and created and last_modified are unset
+// when unknown.
+type FilterInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity                   uint64                 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	BucketCount                uint64                 `protobuf:"varint,3,opt,name=bucket_count,json=bucketCount,proto3" json:"bucket_count,omitempty"`
+	MemoryBytes                uint64                 `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	Count                      uint64                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	LoadFactor                 float64                `protobuf:"fixed64,6,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"`
+	EstimatedFalsePositiveRate float64                `protobuf:"fixed64,7,opt,name=estimated_false_positive_rate,json=estimatedFalsePositiveRate,proto3" json:"estimated_false_positive_rate,omitempty"`
+	Created                    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	LastModified               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	Stats                      *FilterStats           `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	SubFilters                 uint32                 `protobuf:"varint,11,opt,name=sub_filters,json=subFilters,proto3" json:"sub_filters,omitempty"`
+	GrowthFactor               uint32                 `protobuf:"varint,12,opt,name=growth_factor,json=growthFactor,proto3" json:"growth_factor,omitempty"`
+	FingerprintBits            uint32                 `protobuf:"varint,13,opt,name=fingerprint_bits,json=fingerprintBits,proto3" json:"fingerprint_bits,omitempty"`
+	BucketSize                 uint32                 `protobuf:"varint,14,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	TargetFalsePositiveRate    float64                `protobuf:"fixed64,15,opt,name=target_false_positive_rate,json=targetFalsePositiveRate,proto3" json:"target_false_positive_rate,omitempty"`
+	Window                     *durationpb.Duration   `protobuf:"bytes,16,opt,name=window,proto3" json:"window,omitempty"`
+	WindowSlices               uint32                 `protobuf:"varint,17,opt,name=window_slices,json=windowSlices,proto3" json:"window_slices,omitempty"`
+	Counting                   bool                   `protobuf:"varint,18,opt,name=counting,proto3" json:"counting,omitempty"`
+	Backend                    string                 `protobuf:"bytes,19,opt,name=backend,proto3" json:"backend,omitempty"`
+	// hash_functions is the number of bits a bloom filter sets per element.
+	HashFunctions uint32 `protobuf:"varint,20,opt,name=hash_functions,json=hashFunctions,proto3" json:"hash_functions,omitempty"`
+	ValueBits     uint32 `protobuf:"varint,21,opt,name=value_bits,json=valueBits,proto3" json:"value_bits,omitempty"`
+}
+
+func (x *FilterInfo) Reset() {
+	*x = FilterInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterInfo) ProtoMessage() {}
+
+func (x *FilterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterInfo.ProtoReflect.Descriptor instead.
+func (*FilterInfo) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FilterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FilterInfo) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetBucketCount() uint64 {
+	if x != nil {
+		return x.BucketCount
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetLoadFactor() float64 {
+	if x != nil {
+		return x.LoadFactor
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetEstimatedFalsePositiveRate() float64 {
+	if x != nil {
+		return x.EstimatedFalsePositiveRate
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *FilterInfo) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+func (x *FilterInfo) GetStats() *FilterStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *FilterInfo) GetSubFilters() uint32 {
+	if x != nil {
+		return x.SubFilters
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetGrowthFactor() uint32 {
+	if x != nil {
+		return x.GrowthFactor
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetFingerprintBits() uint32 {
+	if x != nil {
+		return x.FingerprintBits
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetBucketSize() uint32 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetTargetFalsePositiveRate() float64 {
+	if x != nil {
+		return x.TargetFalsePositiveRate
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *FilterInfo) GetWindowSlices() uint32 {
+	if x != nil {
+		return x.WindowSlices
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetCounting() bool {
+	if x != nil {
+		return x.Counting
+	}
+	return false
+}
+
+func (x *FilterInfo) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *FilterInfo) GetHashFunctions() uint32 {
+	if x != nil {
+		return x.HashFunctions
+	}
+	return 0
+}
+
+func (x *FilterInfo) GetValueBits() uint32 {
+	if x != nil {
+		return x.ValueBits
+	}
+	return 0
+}
+
+// FilterStats counts the operations on a filter since it was created.
+type FilterStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inserts       uint64 `protobuf:"varint,1,opt,name=inserts,proto3" json:"inserts,omitempty"`
+	FailedInserts uint64 `protobuf:"varint,2,opt,name=failed_inserts,json=failedInserts,proto3" json:"failed_inserts,omitempty"`
+	Deletes       uint64 `protobuf:"varint,3,opt,name=deletes,proto3" json:"deletes,omitempty"`
+	Lookups       uint64 `protobuf:"varint,4,opt,name=lookups,proto3" json:"lookups,omitempty"`
+	Hits          uint64 `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	// Elements removed by the background sweep once their ttl passed.
+	Expired uint64 `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *FilterStats) Reset() {
+	*x = FilterStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterStats) ProtoMessage() {}
+
+func (x *FilterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterStats.ProtoReflect.Descriptor instead.
+func (*FilterStats) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FilterStats) GetInserts() uint64 {
+	if x != nil {
+		return x.Inserts
+	}
+	return 0
+}
+
+func (x *FilterStats) GetFailedInserts() uint64 {
+	if x != nil {
+		return x.FailedInserts
+	}
+	return 0
+}
+
+func (x *FilterStats) GetDeletes() uint64 {
+	if x != nil {
+		return x.Deletes
+	}
+	return 0
+}
+
+func (x *FilterStats) GetLookups() uint64 {
+	if x != nil {
+		return x.Lookups
+	}
+	return 0
+}
+
+func (x *FilterStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *FilterStats) GetExpired() uint64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type GetFilterInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterName string `protobuf:"bytes,1,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
+}
+
+func (x *GetFilterInfoRequest) Reset() {
+	*x = GetFilterInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilterInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterInfoRequest) ProtoMessage() {}
+
+func (x *GetFilterInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetFilterInfoRequest) GetFilterName() string {
+	if x != nil {
+		return x.FilterName
 	}
 	return ""
 }
 
-type LookupElementsStreamResponse struct {
+type GetFilterInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Info   *FilterInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *GetFilterInfoResponse) Reset() {
+	*x = GetFilterInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilterInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterInfoResponse) ProtoMessage() {}
+
+func (x *GetFilterInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetFilterInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetFilterInfoResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetFilterInfoResponse) GetInfo() *FilterInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// DescribeFiltersRequest lists the filters to describe; all filters are
+// described when it is empty.
+type DescribeFiltersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Element string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	FilterNames []string `protobuf:"bytes,1,rep,name=filter_names,json=filterNames,proto3" json:"filter_names,omitempty"`
 }
 
-func (x *LookupElementsStreamResponse) Reset() {
-	*x = LookupElementsStreamResponse{}
+func (x *DescribeFiltersRequest) Reset() {
+	*x = DescribeFiltersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[21]
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupElementsStreamResponse) String() string {
+func (x *DescribeFiltersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupElementsStreamResponse) ProtoMessage() {}
+func (*DescribeFiltersRequest) ProtoMessage() {}
 
-func (x *LookupElementsStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[21]
+func (x *DescribeFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,16 +3753,79 @@ func (x *LookupElementsStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupElementsStreamResponse.ProtoReflect.Descriptor instead.
-func (*LookupElementsStreamResponse) Descriptor() ([]byte, []int) {
-	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DescribeFiltersRequest.ProtoReflect.Descriptor instead.
+func (*DescribeFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *LookupElementsStreamResponse) GetElement() string {
+func (x *DescribeFiltersRequest) GetFilterNames() []string {
 	if x != nil {
-		return x.Element
+		return x.FilterNames
 	}
-	return ""
+	return nil
+}
+
+type DescribeFiltersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Filters        []*FilterInfo `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	MissingFilters []string      `protobuf:"bytes,3,rep,name=missing_filters,json=missingFilters,proto3" json:"missing_filters,omitempty"`
+}
+
+func (x *DescribeFiltersResponse) Reset() {
+	*x = DescribeFiltersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeFiltersResponse) ProtoMessage() {}
+
+func (x *DescribeFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cuckoofilter_cuckoofilter_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeFiltersResponse.ProtoReflect.Descriptor instead.
+func (*DescribeFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_cuckoofilter_cuckoofilter_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DescribeFiltersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DescribeFiltersResponse) GetFilters() []*FilterInfo {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *DescribeFiltersResponse) GetMissingFilters() []string {
+	if x != nil {
+		return x.MissingFilters
+	}
+	return nil
 }
 
 var File_cuckoofilter_cuckoofilter_proto protoreflect.FileDescriptor
@@ -1166,186 +3835,667 @@ var file_cuckoofilter_cuckoofilter_proto_rawDesc = []byte{
 	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0c, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
 	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x52, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x67, 0x0a,
+	0x0e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x06, 0x52, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xb4, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x67,
+	0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x72, 0x6f, 0x77, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x69, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x6c,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x69, 0x74, 0x73, 0x22, 0x44, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x5d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
 	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xb5, 0x01, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xbc, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9f,
+	0x01, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x72,
+	0x61, 0x77, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xf7, 0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x6b, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x61, 0x63, 0x6b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xc0, 0x01, 0x0a, 0x1c, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75,
 	0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a,
-	0x15, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x59, 0x0a,
+	0x0d, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63,
+	0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x78, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65,
 	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c,
+	0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b,
+	0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x19, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a,
+	0x13, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x06, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x69, 0x0a,
+	0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x06, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x06,
+	0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x68, 0x61, 0x73, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x14,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x06, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x06, 0x52, 0x0f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c,
 	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37,
-	0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x65, 0x6e,
-	0x22, 0x35, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x14,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x45, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x77, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x57, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
 	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa0, 0x01, 0x0a,
-	0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
-	0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x58, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x1c, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x32, 0x85, 0x08, 0x0a, 0x0c, 0x43, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e,
-	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61,
+	0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x14, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x73, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x79, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x61, 0x63, 0x68, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61,
+	0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0b, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x60, 0x0a,
+	0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x61, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x35, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x5b, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x77, 0x0a, 0x15,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x61, 0x77, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x61,
+	0x77, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x61, 0x77, 0x55,
+	0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xa0, 0x01, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x1c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x44,
+	0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x19, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x06, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x69, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x61, 0x6c, 0x73,
+	0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x69, 0x74,
+	0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x73, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0x3b, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0xa4, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x75,
+	0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x75, 0x63,
+	0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x2a, 0x60, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x52, 0x45,
+	0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x4f,
+	0x4b, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xb3, 0x13, 0x0a,
+	0x0c, 0x43, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x57, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e,
+	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
-	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
-	0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
-	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x75, 0x63,
-	0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63,
-	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b,
-	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45,
 	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
 	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x29,
-	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x75, 0x63, 0x6b,
-	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45,
 	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x40, 0x0a, 0x0c, 0x63,
-	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x75, 0x6f, 0x62, 0x69, 0x6e,
-	0x71, 0x69, 0x75, 0x2f, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2f, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x23,
+	0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x5a, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x63, 0x75, 0x63,
+	0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x29, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x59, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0c,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x61, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x61, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x61,
+	0x63, 0x68, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x60, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x24, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
+	0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x2e,
+	0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x40, 0x0a, 0x0c, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x75, 0x6f, 0x62, 0x69, 0x6e, 0x71, 0x69, 0x75, 0x2f, 0x63, 0x75, 0x63, 0x6b, 0x6f,
+	0x6f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x63, 0x6b, 0x6f, 0x6f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1360,70 +4510,172 @@ func file_cuckoofilter_cuckoofilter_proto_rawDescGZIP() []byte {
 	return file_cuckoofilter_cuckoofilter_proto_rawDescData
 }
 
-var file_cuckoofilter_cuckoofilter_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_cuckoofilter_cuckoofilter_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cuckoofilter_cuckoofilter_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_cuckoofilter_cuckoofilter_proto_goTypes = []interface{}{
-	(*Status)(nil),                       // 0: cuckoofilter.Status
-	(*CreateFilterRequest)(nil),          // 1: cuckoofilter.CreateFilterRequest
-	(*CreateFilterResponse)(nil),         // 2: cuckoofilter.CreateFilterResponse
-	(*DeleteFilterRequest)(nil),          // 3: cuckoofilter.DeleteFilterRequest
-	(*DeleteFilterResponse)(nil),         // 4: cuckoofilter.DeleteFilterResponse
-	(*ListFiltersResponse)(nil),          // 5: cuckoofilter.ListFiltersResponse
-	(*InsertElementRequest)(nil),         // 6: cuckoofilter.InsertElementRequest
-	(*InsertElementResponse)(nil),        // 7: cuckoofilter.InsertElementResponse
-	(*InsertElementsRequest)(nil),        // 8: cuckoofilter.InsertElementsRequest
-	(*InsertElementsResponse)(nil),       // 9: cuckoofilter.InsertElementsResponse
-	(*DeleteElementRequest)(nil),         // 10: cuckoofilter.DeleteElementRequest
-	(*DeleteElementResponse)(nil),        // 11: cuckoofilter.DeleteElementResponse
-	(*CountElementsRequest)(nil),         // 12: cuckoofilter.CountElementsRequest
-	(*CountElementsResponse)(nil),        // 13: cuckoofilter.CountElementsResponse
-	(*ResetFilterRequest)(nil),           // 14: cuckoofilter.ResetFilterRequest
-	(*ResetFilterResponse)(nil),          // 15: cuckoofilter.ResetFilterResponse
-	(*LookupElementRequest)(nil),         // 16: cuckoofilter.LookupElementRequest
-	(*LookupElementResponse)(nil),        // 17: cuckoofilter.LookupElementResponse
-	(*LookupElementsRequest)(nil),        // 18: cuckoofilter.LookupElementsRequest
-	(*LookupElementsResponse)(nil),       // 19: cuckoofilter.LookupElementsResponse
-	(*LookupElementsStreamRequest)(nil),  // 20: cuckoofilter.LookupElementsStreamRequest
-	(*LookupElementsStreamResponse)(nil), // 21: cuckoofilter.LookupElementsStreamResponse
-	(*empty.Empty)(nil),                  // 22: google.protobuf.Empty
+	(InsertResult)(0),                    // 0: cuckoofilter.InsertResult
+	(DeleteResult)(0),                    // 1: cuckoofilter.DeleteResult
+	(LookupResult)(0),                    // 2: cuckoofilter.LookupResult
+	(*Status)(nil),                       // 3: cuckoofilter.Status
+	(*FailedElements)(nil),               // 4: cuckoofilter.FailedElements
+	(*CreateFilterRequest)(nil),          // 5: cuckoofilter.CreateFilterRequest
+	(*CreateFilterResponse)(nil),         // 6: cuckoofilter.CreateFilterResponse
+	(*DeleteFilterRequest)(nil),          // 7: cuckoofilter.DeleteFilterRequest
+	(*DeleteFilterResponse)(nil),         // 8: cuckoofilter.DeleteFilterResponse
+	(*ListFiltersResponse)(nil),          // 9: cuckoofilter.ListFiltersResponse
+	(*InsertElementRequest)(nil),         // 10: cuckoofilter.InsertElementRequest
+	(*InsertElementResponse)(nil),        // 11: cuckoofilter.InsertElementResponse
+	(*InsertElementsRequest)(nil),        // 12: cuckoofilter.InsertElementsRequest
+	(*InsertElementsResponse)(nil),       // 13: cuckoofilter.InsertElementsResponse
+	(*InsertElementsStreamRequest)(nil),  // 14: cuckoofilter.InsertElementsStreamRequest
+	(*InsertElementsStreamResponse)(nil), // 15: cuckoofilter.InsertElementsStreamResponse
+	(*InsertFailure)(nil),                // 16: cuckoofilter.InsertFailure
+	(*InsertUniqueRequest)(nil),          // 17: cuckoofilter.InsertUniqueRequest
+	(*InsertUniqueResponse)(nil),         // 18: cuckoofilter.InsertUniqueResponse
+	(*InsertUniqueBatchRequest)(nil),     // 19: cuckoofilter.InsertUniqueBatchRequest
+	(*InsertUniqueBatchResponse)(nil),    // 20: cuckoofilter.InsertUniqueBatchResponse
+	(*InsertHashesRequest)(nil),          // 21: cuckoofilter.InsertHashesRequest
+	(*InsertHashesResponse)(nil),         // 22: cuckoofilter.InsertHashesResponse
+	(*LookupHashesRequest)(nil),          // 23: cuckoofilter.LookupHashesRequest
+	(*LookupHashesResponse)(nil),         // 24: cuckoofilter.LookupHashesResponse
+	(*DeleteElementRequest)(nil),         // 25: cuckoofilter.DeleteElementRequest
+	(*DeleteElementResponse)(nil),        // 26: cuckoofilter.DeleteElementResponse
+	(*DeleteElementsRequest)(nil),        // 27: cuckoofilter.DeleteElementsRequest
+	(*DeleteElementsResponse)(nil),       // 28: cuckoofilter.DeleteElementsResponse
+	(*CountElementsRequest)(nil),         // 29: cuckoofilter.CountElementsRequest
+	(*CountElementsResponse)(nil),        // 30: cuckoofilter.CountElementsResponse
+	(*CountElementRequest)(nil),          // 31: cuckoofilter.CountElementRequest
+	(*CountElementResponse)(nil),         // 32: cuckoofilter.CountElementResponse
+	(*GetElementValueRequest)(nil),       // 33: cuckoofilter.GetElementValueRequest
+	(*GetElementValueResponse)(nil),      // 34: cuckoofilter.GetElementValueResponse
+	(*CountEachElementRequest)(nil),      // 35: cuckoofilter.CountEachElementRequest
+	(*CountEachElementResponse)(nil),     // 36: cuckoofilter.CountEachElementResponse
+	(*ResetFilterRequest)(nil),           // 37: cuckoofilter.ResetFilterRequest
+	(*ResetFilterResponse)(nil),          // 38: cuckoofilter.ResetFilterResponse
+	(*LookupElementRequest)(nil),         // 39: cuckoofilter.LookupElementRequest
+	(*LookupElementResponse)(nil),        // 40: cuckoofilter.LookupElementResponse
+	(*LookupElementsRequest)(nil),        // 41: cuckoofilter.LookupElementsRequest
+	(*LookupElementsResponse)(nil),       // 42: cuckoofilter.LookupElementsResponse
+	(*LookupElementsStreamRequest)(nil),  // 43: cuckoofilter.LookupElementsStreamRequest
+	(*LookupElementsStreamResponse)(nil), // 44: cuckoofilter.LookupElementsStreamResponse
+	(*ExportFilterRequest)(nil),          // 45: cuckoofilter.ExportFilterRequest
+	(*ExportFilterResponse)(nil),         // 46: cuckoofilter.ExportFilterResponse
+	(*ImportFilterRequest)(nil),          // 47: cuckoofilter.ImportFilterRequest
+	(*ImportFilterResponse)(nil),         // 48: cuckoofilter.ImportFilterResponse
+	(*BuildStaticFilterRequest)(nil),     // 49: cuckoofilter.BuildStaticFilterRequest
+	(*BuildStaticFilterResponse)(nil),    // 50: cuckoofilter.BuildStaticFilterResponse
+	(*FilterInfo)(nil),                   // 51: cuckoofilter.FilterInfo
+	(*FilterStats)(nil),                  // 52: cuckoofilter.FilterStats
+	(*GetFilterInfoRequest)(nil),         // 53: cuckoofilter.GetFilterInfoRequest
+	(*GetFilterInfoResponse)(nil),        // 54: cuckoofilter.GetFilterInfoResponse
+	(*DescribeFiltersRequest)(nil),       // 55: cuckoofilter.DescribeFiltersRequest
+	(*DescribeFiltersResponse)(nil),      // 56: cuckoofilter.DescribeFiltersResponse
+	(*durationpb.Duration)(nil),          // 57: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 58: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                  // 59: google.protobuf.Empty
 }
 var file_cuckoofilter_cuckoofilter_proto_depIdxs = []int32{
-	0,  // 0: cuckoofilter.CreateFilterResponse.status:type_name -> cuckoofilter.Status
-	0,  // 1: cuckoofilter.DeleteFilterResponse.status:type_name -> cuckoofilter.Status
-	0,  // 2: cuckoofilter.ListFiltersResponse.status:type_name -> cuckoofilter.Status
-	0,  // 3: cuckoofilter.InsertElementResponse.status:type_name -> cuckoofilter.Status
-	0,  // 4: cuckoofilter.InsertElementsResponse.status:type_name -> cuckoofilter.Status
-	0,  // 5: cuckoofilter.DeleteElementResponse.status:type_name -> cuckoofilter.Status
-	0,  // 6: cuckoofilter.CountElementsResponse.status:type_name -> cuckoofilter.Status
-	0,  // 7: cuckoofilter.ResetFilterResponse.status:type_name -> cuckoofilter.Status
-	0,  // 8: cuckoofilter.LookupElementResponse.status:type_name -> cuckoofilter.Status
-	0,  // 9: cuckoofilter.LookupElementsResponse.status:type_name -> cuckoofilter.Status
-	1,  // 10: cuckoofilter.CuckooFilter.CreateFilter:input_type -> cuckoofilter.CreateFilterRequest
-	3,  // 11: cuckoofilter.CuckooFilter.DeleteFilter:input_type -> cuckoofilter.DeleteFilterRequest
-	22, // 12: cuckoofilter.CuckooFilter.ListFilters:input_type -> google.protobuf.Empty
-	6,  // 13: cuckoofilter.CuckooFilter.InsertElement:input_type -> cuckoofilter.InsertElementRequest
-	8,  // 14: cuckoofilter.CuckooFilter.InsertElements:input_type -> cuckoofilter.InsertElementsRequest
-	10, // 15: cuckoofilter.CuckooFilter.DeleteElement:input_type -> cuckoofilter.DeleteElementRequest
-	12, // 16: cuckoofilter.CuckooFilter.CountElements:input_type -> cuckoofilter.CountElementsRequest
-	14, // 17: cuckoofilter.CuckooFilter.ResetFilter:input_type -> cuckoofilter.ResetFilterRequest
-	16, // 18: cuckoofilter.CuckooFilter.LookupElement:input_type -> cuckoofilter.LookupElementRequest
-	18, // 19: cuckoofilter.CuckooFilter.LookupElements:input_type -> cuckoofilter.LookupElementsRequest
-	20, // 20: cuckoofilter.CuckooFilter.LookupElementsStream:input_type -> cuckoofilter.LookupElementsStreamRequest
-	2,  // 21: cuckoofilter.CuckooFilter.CreateFilter:output_type -> cuckoofilter.CreateFilterResponse
-	4,  // 22: cuckoofilter.CuckooFilter.DeleteFilter:output_type -> cuckoofilter.DeleteFilterResponse
-	5,  // 23: cuckoofilter.CuckooFilter.ListFilters:output_type -> cuckoofilter.ListFiltersResponse
-	7,  // 24: cuckoofilter.CuckooFilter.InsertElement:output_type -> cuckoofilter.InsertElementResponse
-	9,  // 25: cuckoofilter.CuckooFilter.InsertElements:output_type -> cuckoofilter.InsertElementsResponse
-	11, // 26: cuckoofilter.CuckooFilter.DeleteElement:output_type -> cuckoofilter.DeleteElementResponse
-	13, // 27: cuckoofilter.CuckooFilter.CountElements:output_type -> cuckoofilter.CountElementsResponse
-	15, // 28: cuckoofilter.CuckooFilter.ResetFilter:output_type -> cuckoofilter.ResetFilterResponse
-	17, // 29: cuckoofilter.CuckooFilter.LookupElement:output_type -> cuckoofilter.LookupElementResponse
-	19, // 30: cuckoofilter.CuckooFilter.LookupElements:output_type -> cuckoofilter.LookupElementsResponse
-	21, // 31: cuckoofilter.CuckooFilter.LookupElementsStream:output_type -> cuckoofilter.LookupElementsStreamResponse
-	21, // [21:32] is the sub-list for method output_type
-	10, // [10:21] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	57, // 0: cuckoofilter.CreateFilterRequest.window:type_name -> google.protobuf.Duration
+	3,  // 1: cuckoofilter.CreateFilterResponse.status:type_name -> cuckoofilter.Status
+	3,  // 2: cuckoofilter.DeleteFilterResponse.status:type_name -> cuckoofilter.Status
+	3,  // 3: cuckoofilter.ListFiltersResponse.status:type_name -> cuckoofilter.Status
+	57, // 4: cuckoofilter.InsertElementRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 5: cuckoofilter.InsertElementResponse.status:type_name -> cuckoofilter.Status
+	57, // 6: cuckoofilter.InsertElementsRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 7: cuckoofilter.InsertElementsResponse.status:type_name -> cuckoofilter.Status
+	57, // 8: cuckoofilter.InsertElementsStreamRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 9: cuckoofilter.InsertElementsStreamResponse.status:type_name -> cuckoofilter.Status
+	16, // 10: cuckoofilter.InsertElementsStreamResponse.failures:type_name -> cuckoofilter.InsertFailure
+	3,  // 11: cuckoofilter.InsertFailure.status:type_name -> cuckoofilter.Status
+	57, // 12: cuckoofilter.InsertUniqueRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 13: cuckoofilter.InsertUniqueResponse.status:type_name -> cuckoofilter.Status
+	0,  // 14: cuckoofilter.InsertUniqueResponse.result:type_name -> cuckoofilter.InsertResult
+	57, // 15: cuckoofilter.InsertUniqueBatchRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 16: cuckoofilter.InsertUniqueBatchResponse.status:type_name -> cuckoofilter.Status
+	0,  // 17: cuckoofilter.InsertUniqueBatchResponse.results:type_name -> cuckoofilter.InsertResult
+	57, // 18: cuckoofilter.InsertHashesRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 19: cuckoofilter.InsertHashesResponse.status:type_name -> cuckoofilter.Status
+	3,  // 20: cuckoofilter.LookupHashesResponse.status:type_name -> cuckoofilter.Status
+	3,  // 21: cuckoofilter.DeleteElementResponse.status:type_name -> cuckoofilter.Status
+	3,  // 22: cuckoofilter.DeleteElementsResponse.status:type_name -> cuckoofilter.Status
+	1,  // 23: cuckoofilter.DeleteElementsResponse.results:type_name -> cuckoofilter.DeleteResult
+	3,  // 24: cuckoofilter.CountElementsResponse.status:type_name -> cuckoofilter.Status
+	3,  // 25: cuckoofilter.CountElementResponse.status:type_name -> cuckoofilter.Status
+	3,  // 26: cuckoofilter.GetElementValueResponse.status:type_name -> cuckoofilter.Status
+	3,  // 27: cuckoofilter.CountEachElementResponse.status:type_name -> cuckoofilter.Status
+	3,  // 28: cuckoofilter.ResetFilterResponse.status:type_name -> cuckoofilter.Status
+	3,  // 29: cuckoofilter.LookupElementResponse.status:type_name -> cuckoofilter.Status
+	3,  // 30: cuckoofilter.LookupElementsResponse.status:type_name -> cuckoofilter.Status
+	3,  // 31: cuckoofilter.LookupElementsStreamResponse.status:type_name -> cuckoofilter.Status
+	2,  // 32: cuckoofilter.LookupElementsStreamResponse.result:type_name -> cuckoofilter.LookupResult
+	3,  // 33: cuckoofilter.ExportFilterResponse.status:type_name -> cuckoofilter.Status
+	3,  // 34: cuckoofilter.ImportFilterResponse.status:type_name -> cuckoofilter.Status
+	3,  // 35: cuckoofilter.BuildStaticFilterResponse.status:type_name -> cuckoofilter.Status
+	58, // 36: cuckoofilter.FilterInfo.created:type_name -> google.protobuf.Timestamp
+	58, // 37: cuckoofilter.FilterInfo.last_modified:type_name -> google.protobuf.Timestamp
+	52, // 38: cuckoofilter.FilterInfo.stats:type_name -> cuckoofilter.FilterStats
+	57, // 39: cuckoofilter.FilterInfo.window:type_name -> google.protobuf.Duration
+	3,  // 40: cuckoofilter.GetFilterInfoResponse.status:type_name -> cuckoofilter.Status
+	51, // 41: cuckoofilter.GetFilterInfoResponse.info:type_name -> cuckoofilter.FilterInfo
+	3,  // 42: cuckoofilter.DescribeFiltersResponse.status:type_name -> cuckoofilter.Status
+	51, // 43: cuckoofilter.DescribeFiltersResponse.filters:type_name -> cuckoofilter.FilterInfo
+	5,  // 44: cuckoofilter.CuckooFilter.CreateFilter:input_type -> cuckoofilter.CreateFilterRequest
+	7,  // 45: cuckoofilter.CuckooFilter.DeleteFilter:input_type -> cuckoofilter.DeleteFilterRequest
+	59, // 46: cuckoofilter.CuckooFilter.ListFilters:input_type -> google.protobuf.Empty
+	10, // 47: cuckoofilter.CuckooFilter.InsertElement:input_type -> cuckoofilter.InsertElementRequest
+	12, // 48: cuckoofilter.CuckooFilter.InsertElements:input_type -> cuckoofilter.InsertElementsRequest
+	14, // 49: cuckoofilter.CuckooFilter.InsertElementsStream:input_type -> cuckoofilter.InsertElementsStreamRequest
+	25, // 50: cuckoofilter.CuckooFilter.DeleteElement:input_type -> cuckoofilter.DeleteElementRequest
+	27, // 51: cuckoofilter.CuckooFilter.DeleteElements:input_type -> cuckoofilter.DeleteElementsRequest
+	27, // 52: cuckoofilter.CuckooFilter.DeleteElementsStream:input_type -> cuckoofilter.DeleteElementsRequest
+	29, // 53: cuckoofilter.CuckooFilter.CountElements:input_type -> cuckoofilter.CountElementsRequest
+	37, // 54: cuckoofilter.CuckooFilter.ResetFilter:input_type -> cuckoofilter.ResetFilterRequest
+	39, // 55: cuckoofilter.CuckooFilter.LookupElement:input_type -> cuckoofilter.LookupElementRequest
+	41, // 56: cuckoofilter.CuckooFilter.LookupElements:input_type -> cuckoofilter.LookupElementsRequest
+	43, // 57: cuckoofilter.CuckooFilter.LookupElementsStream:input_type -> cuckoofilter.LookupElementsStreamRequest
+	45, // 58: cuckoofilter.CuckooFilter.ExportFilter:input_type -> cuckoofilter.ExportFilterRequest
+	47, // 59: cuckoofilter.CuckooFilter.ImportFilter:input_type -> cuckoofilter.ImportFilterRequest
+	53, // 60: cuckoofilter.CuckooFilter.GetFilterInfo:input_type -> cuckoofilter.GetFilterInfoRequest
+	55, // 61: cuckoofilter.CuckooFilter.DescribeFilters:input_type -> cuckoofilter.DescribeFiltersRequest
+	31, // 62: cuckoofilter.CuckooFilter.CountElement:input_type -> cuckoofilter.CountElementRequest
+	35, // 63: cuckoofilter.CuckooFilter.CountEachElement:input_type -> cuckoofilter.CountEachElementRequest
+	49, // 64: cuckoofilter.CuckooFilter.BuildStaticFilter:input_type -> cuckoofilter.BuildStaticFilterRequest
+	33, // 65: cuckoofilter.CuckooFilter.GetElementValue:input_type -> cuckoofilter.GetElementValueRequest
+	17, // 66: cuckoofilter.CuckooFilter.InsertUnique:input_type -> cuckoofilter.InsertUniqueRequest
+	19, // 67: cuckoofilter.CuckooFilter.InsertUniqueBatch:input_type -> cuckoofilter.InsertUniqueBatchRequest
+	21, // 68: cuckoofilter.CuckooFilter.InsertHashes:input_type -> cuckoofilter.InsertHashesRequest
+	23, // 69: cuckoofilter.CuckooFilter.LookupHashes:input_type -> cuckoofilter.LookupHashesRequest
+	6,  // 70: cuckoofilter.CuckooFilter.CreateFilter:output_type -> cuckoofilter.CreateFilterResponse
+	8,  // 71: cuckoofilter.CuckooFilter.DeleteFilter:output_type -> cuckoofilter.DeleteFilterResponse
+	9,  // 72: cuckoofilter.CuckooFilter.ListFilters:output_type -> cuckoofilter.ListFiltersResponse
+	11, // 73: cuckoofilter.CuckooFilter.InsertElement:output_type -> cuckoofilter.InsertElementResponse
+	13, // 74: cuckoofilter.CuckooFilter.InsertElements:output_type -> cuckoofilter.InsertElementsResponse
+	15, // 75: cuckoofilter.CuckooFilter.InsertElementsStream:output_type -> cuckoofilter.InsertElementsStreamResponse
+	26, // 76: cuckoofilter.CuckooFilter.DeleteElement:output_type -> cuckoofilter.DeleteElementResponse
+	28, // 77: cuckoofilter.CuckooFilter.DeleteElements:output_type -> cuckoofilter.DeleteElementsResponse
+	28, // 78: cuckoofilter.CuckooFilter.DeleteElementsStream:output_type -> cuckoofilter.DeleteElementsResponse
+	30, // 79: cuckoofilter.CuckooFilter.CountElements:output_type -> cuckoofilter.CountElementsResponse
+	38, // 80: cuckoofilter.CuckooFilter.ResetFilter:output_type -> cuckoofilter.ResetFilterResponse
+	40, // 81: cuckoofilter.CuckooFilter.LookupElement:output_type -> cuckoofilter.LookupElementResponse
+	42, // 82: cuckoofilter.CuckooFilter.LookupElements:output_type -> cuckoofilter.LookupElementsResponse
+	44, // 83: cuckoofilter.CuckooFilter.LookupElementsStream:output_type -> cuckoofilter.LookupElementsStreamResponse
+	46, // 84: cuckoofilter.CuckooFilter.ExportFilter:output_type -> cuckoofilter.ExportFilterResponse
+	48, // 85: cuckoofilter.CuckooFilter.ImportFilter:output_type -> cuckoofilter.ImportFilterResponse
+	54, // 86: cuckoofilter.CuckooFilter.GetFilterInfo:output_type -> cuckoofilter.GetFilterInfoResponse
+	56, // 87: cuckoofilter.CuckooFilter.DescribeFilters:output_type -> cuckoofilter.DescribeFiltersResponse
+	32, // 88: cuckoofilter.CuckooFilter.CountElement:output_type -> cuckoofilter.CountElementResponse
+	36, // 89: cuckoofilter.CuckooFilter.CountEachElement:output_type -> cuckoofilter.CountEachElementResponse
+	50, // 90: cuckoofilter.CuckooFilter.BuildStaticFilter:output_type -> cuckoofilter.BuildStaticFilterResponse
+	34, // 91: cuckoofilter.CuckooFilter.GetElementValue:output_type -> cuckoofilter.GetElementValueResponse
+	18, // 92: cuckoofilter.CuckooFilter.InsertUnique:output_type -> cuckoofilter.InsertUniqueResponse
+	20, // 93: cuckoofilter.CuckooFilter.InsertUniqueBatch:output_type -> cuckoofilter.InsertUniqueBatchResponse
+	22, // 94: cuckoofilter.CuckooFilter.InsertHashes:output_type -> cuckoofilter.InsertHashesResponse
+	24, // 95: cuckoofilter.CuckooFilter.LookupHashes:output_type -> cuckoofilter.LookupHashesResponse
+	70, // [70:96] is the sub-list for method output_type
+	44, // [44:70] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_cuckoofilter_cuckoofilter_proto_init() }
@@ -1444,8 +4696,140 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFilterRequest); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedElements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertElementsStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,8 +4840,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFilterResponse); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,8 +4852,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFilterRequest); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertUniqueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,8 +4864,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFilterResponse); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertUniqueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1492,8 +4876,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFiltersResponse); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertUniqueBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1504,8 +4888,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InsertElementRequest); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertUniqueBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1516,8 +4900,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InsertElementResponse); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertHashesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1528,8 +4912,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InsertElementsRequest); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertHashesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1540,8 +4924,8 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InsertElementsResponse); i {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupHashesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1552,7 +4936,19 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupHashesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteElementRequest); i {
 			case 0:
 				return &v.state
@@ -1564,7 +4960,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteElementResponse); i {
 			case 0:
 				return &v.state
@@ -1576,7 +4972,31 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteElementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteElementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountElementsRequest); i {
 			case 0:
 				return &v.state
@@ -1588,7 +5008,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountElementsResponse); i {
 			case 0:
 				return &v.state
@@ -1600,7 +5020,79 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountElementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountElementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElementValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElementValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountEachElementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountEachElementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetFilterRequest); i {
 			case 0:
 				return &v.state
@@ -1612,7 +5104,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetFilterResponse); i {
 			case 0:
 				return &v.state
@@ -1624,7 +5116,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementRequest); i {
 			case 0:
 				return &v.state
@@ -1636,7 +5128,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementResponse); i {
 			case 0:
 				return &v.state
@@ -1648,7 +5140,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementsRequest); i {
 			case 0:
 				return &v.state
@@ -1660,7 +5152,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementsResponse); i {
 			case 0:
 				return &v.state
@@ -1672,7 +5164,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementsStreamRequest); i {
 			case 0:
 				return &v.state
@@ -1684,7 +5176,7 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
-		file_cuckoofilter_cuckoofilter_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupElementsStreamResponse); i {
 			case 0:
 				return &v.state
@@ -1696,19 +5188,164 @@ func file_cuckoofilter_cuckoofilter_proto_init() {
 				return nil
 			}
 		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildStaticFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildStaticFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeFiltersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cuckoofilter_cuckoofilter_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cuckoofilter_cuckoofilter_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cuckoofilter_cuckoofilter_proto_goTypes,
 		DependencyIndexes: file_cuckoofilter_cuckoofilter_proto_depIdxs,
+		EnumInfos:         file_cuckoofilter_cuckoofilter_proto_enumTypes,
 		MessageInfos:      file_cuckoofilter_cuckoofilter_proto_msgTypes,
 	}.Build()
 	File_cuckoofilter_cuckoofilter_proto = out.File
@@ -24,12 +24,27 @@ type CuckooFilterClient interface {
 	ListFilters(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListFiltersResponse, error)
 	InsertElement(ctx context.Context, in *InsertElementRequest, opts ...grpc.CallOption) (*InsertElementResponse, error)
 	InsertElements(ctx context.Context, in *InsertElementsRequest, opts ...grpc.CallOption) (*InsertElementsResponse, error)
+	InsertElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_InsertElementsStreamClient, error)
 	DeleteElement(ctx context.Context, in *DeleteElementRequest, opts ...grpc.CallOption) (*DeleteElementResponse, error)
+	DeleteElements(ctx context.Context, in *DeleteElementsRequest, opts ...grpc.CallOption) (*DeleteElementsResponse, error)
+	DeleteElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_DeleteElementsStreamClient, error)
 	CountElements(ctx context.Context, in *CountElementsRequest, opts ...grpc.CallOption) (*CountElementsResponse, error)
 	ResetFilter(ctx context.Context, in *ResetFilterRequest, opts ...grpc.CallOption) (*ResetFilterResponse, error)
 	LookupElement(ctx context.Context, in *LookupElementRequest, opts ...grpc.CallOption) (*LookupElementResponse, error)
 	LookupElements(ctx context.Context, in *LookupElementsRequest, opts ...grpc.CallOption) (*LookupElementsResponse, error)
 	LookupElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_LookupElementsStreamClient, error)
+	ExportFilter(ctx context.Context, in *ExportFilterRequest, opts ...grpc.CallOption) (CuckooFilter_ExportFilterClient, error)
+	ImportFilter(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_ImportFilterClient, error)
+	GetFilterInfo(ctx context.Context, in *GetFilterInfoRequest, opts ...grpc.CallOption) (*GetFilterInfoResponse, error)
+	DescribeFilters(ctx context.Context, in *DescribeFiltersRequest, opts ...grpc.CallOption) (*DescribeFiltersResponse, error)
+	CountElement(ctx context.Context, in *CountElementRequest, opts ...grpc.CallOption) (*CountElementResponse, error)
+	CountEachElement(ctx context.Context, in *CountEachElementRequest, opts ...grpc.CallOption) (*CountEachElementResponse, error)
+	BuildStaticFilter(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_BuildStaticFilterClient, error)
+	GetElementValue(ctx context.Context, in *GetElementValueRequest, opts ...grpc.CallOption) (*GetElementValueResponse, error)
+	InsertUnique(ctx context.Context, in *InsertUniqueRequest, opts ...grpc.CallOption) (*InsertUniqueResponse, error)
+	InsertUniqueBatch(ctx context.Context, in *InsertUniqueBatchRequest, opts ...grpc.CallOption) (*InsertUniqueBatchResponse, error)
+	InsertHashes(ctx context.Context, in *InsertHashesRequest, opts ...grpc.CallOption) (*InsertHashesResponse, error)
+	LookupHashes(ctx context.Context, in *LookupHashesRequest, opts ...grpc.CallOption) (*LookupHashesResponse, error)
 }
 
 type cuckooFilterClient struct {
@@ -85,6 +100,37 @@ func (c *cuckooFilterClient) InsertElements(ctx context.Context, in *InsertEleme
 	return out, nil
 }
 
+func (c *cuckooFilterClient) InsertElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_InsertElementsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[0], "/cuckoofilter.CuckooFilter/InsertElementsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cuckooFilterInsertElementsStreamClient{stream}
+	return x, nil
+}
+
+type CuckooFilter_InsertElementsStreamClient interface {
+	Send(*InsertElementsStreamRequest) error
+	Recv() (*InsertElementsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type cuckooFilterInsertElementsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cuckooFilterInsertElementsStreamClient) Send(m *InsertElementsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cuckooFilterInsertElementsStreamClient) Recv() (*InsertElementsStreamResponse, error) {
+	m := new(InsertElementsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cuckooFilterClient) DeleteElement(ctx context.Context, in *DeleteElementRequest, opts ...grpc.CallOption) (*DeleteElementResponse, error) {
 	out := new(DeleteElementResponse)
 	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/DeleteElement", in, out, opts...)
@@ -94,6 +140,46 @@ func (c *cuckooFilterClient) DeleteElement(ctx context.Context, in *DeleteElemen
 	return out, nil
 }
 
+func (c *cuckooFilterClient) DeleteElements(ctx context.Context, in *DeleteElementsRequest, opts ...grpc.CallOption) (*DeleteElementsResponse, error) {
+	out := new(DeleteElementsResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/DeleteElements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) DeleteElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_DeleteElementsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[1], "/cuckoofilter.CuckooFilter/DeleteElementsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cuckooFilterDeleteElementsStreamClient{stream}
+	return x, nil
+}
+
+type CuckooFilter_DeleteElementsStreamClient interface {
+	Send(*DeleteElementsRequest) error
+	Recv() (*DeleteElementsResponse, error)
+	grpc.ClientStream
+}
+
+type cuckooFilterDeleteElementsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cuckooFilterDeleteElementsStreamClient) Send(m *DeleteElementsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cuckooFilterDeleteElementsStreamClient) Recv() (*DeleteElementsResponse, error) {
+	m := new(DeleteElementsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cuckooFilterClient) CountElements(ctx context.Context, in *CountElementsRequest, opts ...grpc.CallOption) (*CountElementsResponse, error) {
 	out := new(CountElementsResponse)
 	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/CountElements", in, out, opts...)
@@ -131,7 +217,7 @@ func (c *cuckooFilterClient) LookupElements(ctx context.Context, in *LookupEleme
 }
 
 func (c *cuckooFilterClient) LookupElementsStream(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_LookupElementsStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[0], "/cuckoofilter.CuckooFilter/LookupElementsStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[2], "/cuckoofilter.CuckooFilter/LookupElementsStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +247,187 @@ func (x *cuckooFilterLookupElementsStreamClient) Recv() (*LookupElementsStreamRe
 	return m, nil
 }
 
+func (c *cuckooFilterClient) ExportFilter(ctx context.Context, in *ExportFilterRequest, opts ...grpc.CallOption) (CuckooFilter_ExportFilterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[3], "/cuckoofilter.CuckooFilter/ExportFilter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cuckooFilterExportFilterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CuckooFilter_ExportFilterClient interface {
+	Recv() (*ExportFilterResponse, error)
+	grpc.ClientStream
+}
+
+type cuckooFilterExportFilterClient struct {
+	grpc.ClientStream
+}
+
+func (x *cuckooFilterExportFilterClient) Recv() (*ExportFilterResponse, error) {
+	m := new(ExportFilterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cuckooFilterClient) ImportFilter(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_ImportFilterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[4], "/cuckoofilter.CuckooFilter/ImportFilter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cuckooFilterImportFilterClient{stream}
+	return x, nil
+}
+
+type CuckooFilter_ImportFilterClient interface {
+	Send(*ImportFilterRequest) error
+	CloseAndRecv() (*ImportFilterResponse, error)
+	grpc.ClientStream
+}
+
+type cuckooFilterImportFilterClient struct {
+	grpc.ClientStream
+}
+
+func (x *cuckooFilterImportFilterClient) Send(m *ImportFilterRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cuckooFilterImportFilterClient) CloseAndRecv() (*ImportFilterResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportFilterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cuckooFilterClient) GetFilterInfo(ctx context.Context, in *GetFilterInfoRequest, opts ...grpc.CallOption) (*GetFilterInfoResponse, error) {
+	out := new(GetFilterInfoResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/GetFilterInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) DescribeFilters(ctx context.Context, in *DescribeFiltersRequest, opts ...grpc.CallOption) (*DescribeFiltersResponse, error) {
+	out := new(DescribeFiltersResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/DescribeFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) CountElement(ctx context.Context, in *CountElementRequest, opts ...grpc.CallOption) (*CountElementResponse, error) {
+	out := new(CountElementResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/CountElement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) CountEachElement(ctx context.Context, in *CountEachElementRequest, opts ...grpc.CallOption) (*CountEachElementResponse, error) {
+	out := new(CountEachElementResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/CountEachElement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) BuildStaticFilter(ctx context.Context, opts ...grpc.CallOption) (CuckooFilter_BuildStaticFilterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CuckooFilter_ServiceDesc.Streams[5], "/cuckoofilter.CuckooFilter/BuildStaticFilter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cuckooFilterBuildStaticFilterClient{stream}
+	return x, nil
+}
+
+type CuckooFilter_BuildStaticFilterClient interface {
+	Send(*BuildStaticFilterRequest) error
+	CloseAndRecv() (*BuildStaticFilterResponse, error)
+	grpc.ClientStream
+}
+
+type cuckooFilterBuildStaticFilterClient struct {
+	grpc.ClientStream
+}
+
+func (x *cuckooFilterBuildStaticFilterClient) Send(m *BuildStaticFilterRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cuckooFilterBuildStaticFilterClient) CloseAndRecv() (*BuildStaticFilterResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BuildStaticFilterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cuckooFilterClient) GetElementValue(ctx context.Context, in *GetElementValueRequest, opts ...grpc.CallOption) (*GetElementValueResponse, error) {
+	out := new(GetElementValueResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/GetElementValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) InsertUnique(ctx context.Context, in *InsertUniqueRequest, opts ...grpc.CallOption) (*InsertUniqueResponse, error) {
+	out := new(InsertUniqueResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/InsertUnique", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) InsertUniqueBatch(ctx context.Context, in *InsertUniqueBatchRequest, opts ...grpc.CallOption) (*InsertUniqueBatchResponse, error) {
+	out := new(InsertUniqueBatchResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/InsertUniqueBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) InsertHashes(ctx context.Context, in *InsertHashesRequest, opts ...grpc.CallOption) (*InsertHashesResponse, error) {
+	out := new(InsertHashesResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/InsertHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuckooFilterClient) LookupHashes(ctx context.Context, in *LookupHashesRequest, opts ...grpc.CallOption) (*LookupHashesResponse, error) {
+	out := new(LookupHashesResponse)
+	err := c.cc.Invoke(ctx, "/cuckoofilter.CuckooFilter/LookupHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CuckooFilterServer is the server API for CuckooFilter service.
 // All implementations must embed UnimplementedCuckooFilterServer
 // for forward compatibility
@@ -170,12 +437,27 @@ type CuckooFilterServer interface {
 	ListFilters(context.Context, *empty.Empty) (*ListFiltersResponse, error)
 	InsertElement(context.Context, *InsertElementRequest) (*InsertElementResponse, error)
 	InsertElements(context.Context, *InsertElementsRequest) (*InsertElementsResponse, error)
+	InsertElementsStream(CuckooFilter_InsertElementsStreamServer) error
 	DeleteElement(context.Context, *DeleteElementRequest) (*DeleteElementResponse, error)
+	DeleteElements(context.Context, *DeleteElementsRequest) (*DeleteElementsResponse, error)
+	DeleteElementsStream(CuckooFilter_DeleteElementsStreamServer) error
 	CountElements(context.Context, *CountElementsRequest) (*CountElementsResponse, error)
 	ResetFilter(context.Context, *ResetFilterRequest) (*ResetFilterResponse, error)
 	LookupElement(context.Context, *LookupElementRequest) (*LookupElementResponse, error)
 	LookupElements(context.Context, *LookupElementsRequest) (*LookupElementsResponse, error)
 	LookupElementsStream(CuckooFilter_LookupElementsStreamServer) error
+	ExportFilter(*ExportFilterRequest, CuckooFilter_ExportFilterServer) error
+	ImportFilter(CuckooFilter_ImportFilterServer) error
+	GetFilterInfo(context.Context, *GetFilterInfoRequest) (*GetFilterInfoResponse, error)
+	DescribeFilters(context.Context, *DescribeFiltersRequest) (*DescribeFiltersResponse, error)
+	CountElement(context.Context, *CountElementRequest) (*CountElementResponse, error)
+	CountEachElement(context.Context, *CountEachElementRequest) (*CountEachElementResponse, error)
+	BuildStaticFilter(CuckooFilter_BuildStaticFilterServer) error
+	GetElementValue(context.Context, *GetElementValueRequest) (*GetElementValueResponse, error)
+	InsertUnique(context.Context, *InsertUniqueRequest) (*InsertUniqueResponse, error)
+	InsertUniqueBatch(context.Context, *InsertUniqueBatchRequest) (*InsertUniqueBatchResponse, error)
+	InsertHashes(context.Context, *InsertHashesRequest) (*InsertHashesResponse, error)
+	LookupHashes(context.Context, *LookupHashesRequest) (*LookupHashesResponse, error)
 	mustEmbedUnimplementedCuckooFilterServer()
 }
 
@@ -198,9 +480,18 @@ func (UnimplementedCuckooFilterServer) InsertElement(context.Context, *InsertEle
 func (UnimplementedCuckooFilterServer) InsertElements(context.Context, *InsertElementsRequest) (*InsertElementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertElements not implemented")
 }
+func (UnimplementedCuckooFilterServer) InsertElementsStream(CuckooFilter_InsertElementsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InsertElementsStream not implemented")
+}
 func (UnimplementedCuckooFilterServer) DeleteElement(context.Context, *DeleteElementRequest) (*DeleteElementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteElement not implemented")
 }
+func (UnimplementedCuckooFilterServer) DeleteElements(context.Context, *DeleteElementsRequest) (*DeleteElementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteElements not implemented")
+}
+func (UnimplementedCuckooFilterServer) DeleteElementsStream(CuckooFilter_DeleteElementsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteElementsStream not implemented")
+}
 func (UnimplementedCuckooFilterServer) CountElements(context.Context, *CountElementsRequest) (*CountElementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountElements not implemented")
 }
@@ -216,6 +507,42 @@ func (UnimplementedCuckooFilterServer) LookupElements(context.Context, *LookupEl
 func (UnimplementedCuckooFilterServer) LookupElementsStream(CuckooFilter_LookupElementsStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method LookupElementsStream not implemented")
 }
+func (UnimplementedCuckooFilterServer) ExportFilter(*ExportFilterRequest, CuckooFilter_ExportFilterServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportFilter not implemented")
+}
+func (UnimplementedCuckooFilterServer) ImportFilter(CuckooFilter_ImportFilterServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportFilter not implemented")
+}
+func (UnimplementedCuckooFilterServer) GetFilterInfo(context.Context, *GetFilterInfoRequest) (*GetFilterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilterInfo not implemented")
+}
+func (UnimplementedCuckooFilterServer) DescribeFilters(context.Context, *DescribeFiltersRequest) (*DescribeFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeFilters not implemented")
+}
+func (UnimplementedCuckooFilterServer) CountElement(context.Context, *CountElementRequest) (*CountElementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountElement not implemented")
+}
+func (UnimplementedCuckooFilterServer) CountEachElement(context.Context, *CountEachElementRequest) (*CountEachElementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountEachElement not implemented")
+}
+func (UnimplementedCuckooFilterServer) BuildStaticFilter(CuckooFilter_BuildStaticFilterServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildStaticFilter not implemented")
+}
+func (UnimplementedCuckooFilterServer) GetElementValue(context.Context, *GetElementValueRequest) (*GetElementValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElementValue not implemented")
+}
+func (UnimplementedCuckooFilterServer) InsertUnique(context.Context, *InsertUniqueRequest) (*InsertUniqueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertUnique not implemented")
+}
+func (UnimplementedCuckooFilterServer) InsertUniqueBatch(context.Context, *InsertUniqueBatchRequest) (*InsertUniqueBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertUniqueBatch not implemented")
+}
+func (UnimplementedCuckooFilterServer) InsertHashes(context.Context, *InsertHashesRequest) (*InsertHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertHashes not implemented")
+}
+func (UnimplementedCuckooFilterServer) LookupHashes(context.Context, *LookupHashesRequest) (*LookupHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupHashes not implemented")
+}
 func (UnimplementedCuckooFilterServer) mustEmbedUnimplementedCuckooFilterServer() {}
 
 // UnsafeCuckooFilterServer may be embedded to opt out of forward compatibility for this service.
@@ -319,6 +646,32 @@ func _CuckooFilter_InsertElements_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CuckooFilter_InsertElementsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CuckooFilterServer).InsertElementsStream(&cuckooFilterInsertElementsStreamServer{stream})
+}
+
+type CuckooFilter_InsertElementsStreamServer interface {
+	Send(*InsertElementsStreamResponse) error
+	Recv() (*InsertElementsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type cuckooFilterInsertElementsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cuckooFilterInsertElementsStreamServer) Send(m *InsertElementsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cuckooFilterInsertElementsStreamServer) Recv() (*InsertElementsStreamRequest, error) {
+	m := new(InsertElementsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CuckooFilter_DeleteElement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteElementRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +690,50 @@ func _CuckooFilter_DeleteElement_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CuckooFilter_DeleteElements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteElementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).DeleteElements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/DeleteElements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).DeleteElements(ctx, req.(*DeleteElementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_DeleteElementsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CuckooFilterServer).DeleteElementsStream(&cuckooFilterDeleteElementsStreamServer{stream})
+}
+
+type CuckooFilter_DeleteElementsStreamServer interface {
+	Send(*DeleteElementsResponse) error
+	Recv() (*DeleteElementsRequest, error)
+	grpc.ServerStream
+}
+
+type cuckooFilterDeleteElementsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cuckooFilterDeleteElementsStreamServer) Send(m *DeleteElementsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cuckooFilterDeleteElementsStreamServer) Recv() (*DeleteElementsRequest, error) {
+	m := new(DeleteElementsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CuckooFilter_CountElements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CountElementsRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +832,241 @@ func (x *cuckooFilterLookupElementsStreamServer) Recv() (*LookupElementsStreamRe
 	return m, nil
 }
 
+func _CuckooFilter_ExportFilter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportFilterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CuckooFilterServer).ExportFilter(m, &cuckooFilterExportFilterServer{stream})
+}
+
+type CuckooFilter_ExportFilterServer interface {
+	Send(*ExportFilterResponse) error
+	grpc.ServerStream
+}
+
+type cuckooFilterExportFilterServer struct {
+	grpc.ServerStream
+}
+
+func (x *cuckooFilterExportFilterServer) Send(m *ExportFilterResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CuckooFilter_ImportFilter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CuckooFilterServer).ImportFilter(&cuckooFilterImportFilterServer{stream})
+}
+
+type CuckooFilter_ImportFilterServer interface {
+	SendAndClose(*ImportFilterResponse) error
+	Recv() (*ImportFilterRequest, error)
+	grpc.ServerStream
+}
+
+type cuckooFilterImportFilterServer struct {
+	grpc.ServerStream
+}
+
+func (x *cuckooFilterImportFilterServer) SendAndClose(m *ImportFilterResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cuckooFilterImportFilterServer) Recv() (*ImportFilterRequest, error) {
+	m := new(ImportFilterRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CuckooFilter_GetFilterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).GetFilterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/GetFilterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).GetFilterInfo(ctx, req.(*GetFilterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_DescribeFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).DescribeFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/DescribeFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).DescribeFilters(ctx, req.(*DescribeFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_CountElement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountElementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).CountElement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/CountElement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).CountElement(ctx, req.(*CountElementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_CountEachElement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountEachElementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).CountEachElement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/CountEachElement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).CountEachElement(ctx, req.(*CountEachElementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_BuildStaticFilter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CuckooFilterServer).BuildStaticFilter(&cuckooFilterBuildStaticFilterServer{stream})
+}
+
+type CuckooFilter_BuildStaticFilterServer interface {
+	SendAndClose(*BuildStaticFilterResponse) error
+	Recv() (*BuildStaticFilterRequest, error)
+	grpc.ServerStream
+}
+
+type cuckooFilterBuildStaticFilterServer struct {
+	grpc.ServerStream
+}
+
+func (x *cuckooFilterBuildStaticFilterServer) SendAndClose(m *BuildStaticFilterResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cuckooFilterBuildStaticFilterServer) Recv() (*BuildStaticFilterRequest, error) {
+	m := new(BuildStaticFilterRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CuckooFilter_GetElementValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElementValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).GetElementValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/GetElementValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).GetElementValue(ctx, req.(*GetElementValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_InsertUnique_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertUniqueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).InsertUnique(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/InsertUnique",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).InsertUnique(ctx, req.(*InsertUniqueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_InsertUniqueBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertUniqueBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).InsertUniqueBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/InsertUniqueBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).InsertUniqueBatch(ctx, req.(*InsertUniqueBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_InsertHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).InsertHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/InsertHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).InsertHashes(ctx, req.(*InsertHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuckooFilter_LookupHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuckooFilterServer).LookupHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cuckoofilter.CuckooFilter/LookupHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuckooFilterServer).LookupHashes(ctx, req.(*LookupHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CuckooFilter_ServiceDesc is the grpc.ServiceDesc for CuckooFilter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -466,6 +1098,10 @@ var CuckooFilter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteElement",
 			Handler:    _CuckooFilter_DeleteElement_Handler,
 		},
+		{
+			MethodName: "DeleteElements",
+			Handler:    _CuckooFilter_DeleteElements_Handler,
+		},
 		{
 			MethodName: "CountElements",
 			Handler:    _CuckooFilter_CountElements_Handler,
@@ -482,14 +1118,77 @@ var CuckooFilter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupElements",
 			Handler:    _CuckooFilter_LookupElements_Handler,
 		},
+		{
+			MethodName: "GetFilterInfo",
+			Handler:    _CuckooFilter_GetFilterInfo_Handler,
+		},
+		{
+			MethodName: "DescribeFilters",
+			Handler:    _CuckooFilter_DescribeFilters_Handler,
+		},
+		{
+			MethodName: "CountElement",
+			Handler:    _CuckooFilter_CountElement_Handler,
+		},
+		{
+			MethodName: "CountEachElement",
+			Handler:    _CuckooFilter_CountEachElement_Handler,
+		},
+		{
+			MethodName: "GetElementValue",
+			Handler:    _CuckooFilter_GetElementValue_Handler,
+		},
+		{
+			MethodName: "InsertUnique",
+			Handler:    _CuckooFilter_InsertUnique_Handler,
+		},
+		{
+			MethodName: "InsertUniqueBatch",
+			Handler:    _CuckooFilter_InsertUniqueBatch_Handler,
+		},
+		{
+			MethodName: "InsertHashes",
+			Handler:    _CuckooFilter_InsertHashes_Handler,
+		},
+		{
+			MethodName: "LookupHashes",
+			Handler:    _CuckooFilter_LookupHashes_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InsertElementsStream",
+			Handler:       _CuckooFilter_InsertElementsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DeleteElementsStream",
+			Handler:       _CuckooFilter_DeleteElementsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "LookupElementsStream",
 			Handler:       _CuckooFilter_LookupElementsStream_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportFilter",
+			Handler:       _CuckooFilter_ExportFilter_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportFilter",
+			Handler:       _CuckooFilter_ImportFilter_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BuildStaticFilter",
+			Handler:       _CuckooFilter_BuildStaticFilter_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "cuckoofilter/cuckoofilter.proto",
 }
@@ -0,0 +1,36 @@
+package cuckoofilter
+
+import (
+	metro "github.com/dgryski/go-metro"
+)
+
+// HashVersion is the version of the element hash computed by HashElement,
+// which InsertHashes and LookupHashes requests name in hash_version. Version
+// 1 is the 64 bit MetroHash of the bytes of an element with seed HashSeed.
+// The server hashes the elements of every other request the same way, so
+// a hash stands for its element in any filter that takes hashes.
+const HashVersion = 1
+
+// HashSeed is the seed of the element hash.
+const HashSeed = 1337
+
+// HashElement returns the hash of an element for InsertHashes and
+// LookupHashes.
+func HashElement(element []byte) uint64 {
+	return metro.Hash64(element, HashSeed)
+}
+
+// HashString returns the hash of an element given as a string, which is the
+// hash of its bytes.
+func HashString(element string) uint64 {
+	return metro.Hash64Str(element, HashSeed)
+}
+
+// HashStrings returns the hashes of elements, in order.
+func HashStrings(elements ...string) []uint64 {
+	hashes := make([]uint64, len(elements))
+	for i, e := range elements {
+		hashes[i] = HashString(e)
+	}
+	return hashes
+}
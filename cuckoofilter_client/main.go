@@ -80,17 +80,17 @@ func lookupElementsStream(c pb.CuckooFilterClient, ctx context.Context, filterNa
 				return
 			}
 			if err != nil {
-				log.Fatal("Failed to receive an element : %v", err)
+				log.Fatalf("Failed to receive an element : %v", err)
 			}
-			log.Println("接收元素：", res.Element)
+			log.Println("接收元素：", res.CorrelationId, res.Element, res.Result)
 		}
 	}()
 
 	//send
 	for i := 0; i < 10; i++ {
 		element := strconv.Itoa(rand.Intn(200))
-		if err := stream.Send(&pb.LookupElementsStreamRequest{FilterName: filterName, Element: element}); err != nil {
-			log.Fatal("Failed to send an element: %v", err)
+		if err := stream.Send(&pb.LookupElementsStreamRequest{FilterName: filterName, Element: element, CorrelationId: uint64(i)}); err != nil {
+			log.Fatalf("Failed to send an element: %v", err)
 		}
 		log.Println("发送元素：", element)
 		time.Sleep(1 * time.Second)
@@ -160,7 +160,7 @@ func lookupElement(c pb.CuckooFilterClient, ctx context.Context, filterName stri
 	if err != nil {
 		log.Fatal(err)
 	}
-	log.Println("查到了吗？", res.Status.Msg)
+	log.Println("查到了吗？", res.Found)
 }
 
 func countElements(c pb.CuckooFilterClient, ctx context.Context, filterName string) {
@@ -11,21 +11,77 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 var (
-	port = flag.Int("port", 50051, "The server port")
+	port               = flag.Int("port", 50051, "The server port")
+	legacyStatus       = flag.Bool("legacy-status", false, "Report failures only in the response Status field instead of as gRPC errors")
+	dataDir            = flag.String("data-dir", "", "Directory the filters are loaded from at startup and snapshotted into; persistence is off when empty")
+	snapshotInterval   = flag.Duration("snapshot-interval", 5*time.Minute, "How often to snapshot the filters into -data-dir; 0 disables periodic snapshots")
+	snapshotOnShutdown = flag.Bool("snapshot-on-shutdown", true, "Snapshot the filters into -data-dir on SIGINT or SIGTERM")
+	shutdownTimeout    = flag.Duration("shutdown-timeout", 30*time.Second, "How long to wait for in-flight RPCs before the final snapshot")
+	walEnabled         = flag.Bool("wal", true, "Log every mutation to a write-ahead log in -data-dir and replay it at startup")
+	walSync            = flag.String("wal-sync", "everysec", "When to fsync the write-ahead log: always, everysec or never")
+	compression        = flag.String("snapshot-compression", "none", "How to compress snapshotted filters: none, gzip or snappy")
+	sweepInterval      = flag.Duration("sweep-interval", time.Minute, "How often to remove elements whose ttl has passed; 0 disables sweeps")
 )
 
 func main() {
 	flag.Parse()
+
+	var opts []server.Option
+	if *legacyStatus {
+		opts = append(opts, server.WithLegacyStatus())
+	}
+	c, err := server.ParseCompression(*compression)
+	if err != nil {
+		log.Fatal(err)
+	}
+	opts = append(opts, server.WithSnapshotCompression(c))
+	srv := server.NewServer(opts...)
+
+	if *dataDir != "" {
+		report, err := srv.Load(*dataDir)
+		if err != nil && !os.IsNotExist(err) {
+			log.Fatalf("failed to load filters from %s: %v", *dataDir, err)
+		}
+		if report != nil {
+			log.Printf("loaded %d filters from %s and replayed %d logged mutations", len(report.Recovered), *dataDir, report.Replayed)
+			if report.ReplayFailed > 0 {
+				log.Printf("%d logged mutations failed to replay the way they originally did", report.ReplayFailed)
+			}
+			if len(report.Quarantined) > 0 {
+				log.Printf("quarantined filters that failed verification: %v", report.Quarantined)
+			}
+			if len(report.Skipped) > 0 {
+				log.Printf("skipped files that are not filters: %v", report.Skipped)
+			}
+		}
+		if *walEnabled {
+			policy, err := server.ParseSyncPolicy(*walSync)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := srv.OpenWAL(*dataDir, policy); err != nil {
+				log.Fatalf("failed to open the write-ahead log in %s: %v", *dataDir, err)
+			}
+		}
+		if *snapshotInterval > 0 {
+			srv.StartSnapshots(*dataDir, *snapshotInterval)
+		}
+	}
+
+	if *sweepInterval > 0 {
+		srv.StartSweeps(*sweepInterval)
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	s := grpc.NewServer()
-	srv := server.NewServer()
 	pb.RegisterCuckooFilterServer(s, srv)
 
 	go func() {
@@ -35,8 +91,37 @@ func main() {
 		}
 	}()
 
-	interrupt := make(chan os.Signal)
+	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
 	<-interrupt
-	s.Stop()
+	stop(s)
+	srv.StopSweeps()
+
+	if *dataDir != "" {
+		srv.StopSnapshots()
+		if *snapshotOnShutdown {
+			if err := srv.Dump(*dataDir); err != nil {
+				log.Fatalf("failed to snapshot filters into %s: %v", *dataDir, err)
+			}
+			log.Printf("filters snapshotted into %s", *dataDir)
+		}
+		if err := srv.CloseWAL(); err != nil {
+			log.Fatalf("failed to close the write-ahead log: %v", err)
+		}
+	}
+}
+
+// stop lets in-flight RPCs finish so that the final snapshot sees every
+// accepted write, but gives up on them after -shutdown-timeout.
+func stop(s *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(*shutdownTimeout):
+		s.Stop()
+	}
 }
@@ -3,11 +3,14 @@ module github.com/guobinqiu/cuckoofilter
 go 1.15
 
 require (
+	github.com/dgryski/go-metro v0.0.0-20200812162917-85c65e2d0165
 	github.com/golang/protobuf v1.5.0
+	github.com/golang/snappy v0.0.4
 	github.com/google/uuid v1.3.0
 	github.com/panmari/cuckoofilter v1.0.3
 	github.com/robfig/cron/v3 v3.0.1 // indirect
 	github.com/stretchr/testify v1.7.0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.44.0
 	google.golang.org/protobuf v1.26.0
 )
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"sort"
+)
+
+// capability is a kind of mutation not every backend supports.
+type capability int
+
+const (
+	canInsert capability = iota
+	canDelete
+	// canExpire is inserting elements with a ttl.
+	canExpire
+	canReset
+	canStoreValues
+	// canHash is taking elements by their hash, as pb.HashElement computes
+	// it.
+	canHash
+)
+
+var capabilityNames = map[capability]string{
+	canInsert:      "insert",
+	canDelete:      "delete",
+	canExpire:      "ttl",
+	canReset:       "reset",
+	canStoreValues: "values",
+	canHash:        "hashes",
+}
+
+// backend is the data structure behind a filter. Backends are not safe for
+// concurrent use; the filter's lock guards them.
+type backend interface {
+	// supports reports whether the backend is capable of c. It does not
+	// change over the life of a backend, so it needs no lock.
+	supports(c capability) bool
+	Insert(data []byte) bool
+	// InsertUntil inserts data to expire at expiry, or never if it is zero.
+	InsertUntil(data []byte, expiry uint32) bool
+	// InsertValue inserts data with value, replacing the value of data if
+	// the backend holds it already. Only backends capable of canStoreValues
+	// store values.
+	InsertValue(data []byte, value uint16, expiry uint32) bool
+	// Value returns the value of data, if the backend holds it.
+	Value(data []byte) (uint16, bool)
+	Delete(data []byte) bool
+	Lookup(data []byte) bool
+	// InsertHash and LookupHash take the hash of an element in place of
+	// the element. Only backends capable of canHash look elements up by
+	// that hash.
+	InsertHash(hash uint64, expiry uint32) bool
+	LookupHash(hash uint64) bool
+	// Occurrences returns about how many times data was inserted.
+	Occurrences(data []byte) uint
+	// Count returns the number of elements.
+	Count() uint
+	Reset()
+	// Sweep removes the elements that expired by now and returns how many.
+	Sweep(now uint32) int
+	// snapshot returns the payload of a snapshot of the backend and records
+	// in h what decoding it needs.
+	snapshot(h *snapshotHeader) []byte
+	// info fills in the size and load of the backend, given its Count.
+	info(info *pb.FilterInfo)
+	// annotate adds to the log record of a mutation what its replay needs.
+	annotate(rec *walRecord)
+	// journal makes the backend record in u how to undo its mutations until
+	// u stops.
+	journal(u *undoLog)
+	// replay applies a logged insert, delete or reset and returns the number
+	// of its elements that failed to insert or delete again.
+	replay(rec *walRecord) int
+}
+
+// backendType creates and decodes the backends of one kind.
+type backendType struct {
+	// check rejects the settings the backend does not support and fills in
+	// the ones it derives from others.
+	check func(config *filterConfig) *errdetails.BadRequest
+	new   func(config filterConfig) backend
+	// decode decodes the payload of a snapshot.
+	decode func(h *snapshotHeader, payload []byte) (backend, error)
+}
+
+// defaultBackend backs filters created without a backend.
+const defaultBackend = "cuckoo"
+
+var backends = map[string]*backendType{}
+
+// registerBackend makes a backend type available under name. It is meant to
+// be called from init functions.
+func registerBackend(name string, t *backendType) {
+	if _, ok := backends[name]; ok {
+		panic(fmt.Sprintf("backend %s registered twice", name))
+	}
+	backends[name] = t
+}
+
+// backendNames lists the registered backends.
+func backendNames() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
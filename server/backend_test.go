@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestCreateFilterBackend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10})
+	assert.NoError(t, err)
+	_, err = s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 10, Backend: "cuckoo"})
+	assert.NoError(t, err)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
+
+	// The backend is recorded in snapshots.
+	assert.NoError(t, s.Dump(dir))
+	s = restart(t, s, dir)
+	for _, name := range []string{"aaa", "bbb"} {
+		res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: name})
+		assert.Equal(t, defaultBackend, res.Info.Backend, name)
+	}
+	assert.True(t, found(s, "bbb", "jack"))
+}
+
+func TestCreateFilterUnknownBackend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10, Backend: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err))
+}
+
+func TestSnapshotUnknownBackend(t *testing.T) {
+	h, payload := createFilter(filterConfig{Capacity: 10}).snapshot("aaa")
+	h.Config.Backend = "nope"
+	b, err := encodeSnapshot(h, payload, CompressionNone)
+	assert.NoError(t, err)
+	_, _, err = decodeSnapshot(b)
+	assert.Error(t, err)
+}
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	metro "github.com/dgryski/go-metro"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"math"
+	"math/bits"
+)
+
+const (
+	// defaultBloomFalsePositiveRate is the false positive rate of bloom
+	// filters created without one.
+	defaultBloomFalsePositiveRate = 0.01
+	// minBloomFalsePositiveRate bounds the size of bloom filters, which
+	// grow without limit as the rate goes to zero.
+	minBloomFalsePositiveRate = 1e-9
+	// maxBloomHashes is more hashes than minBloomFalsePositiveRate needs.
+	maxBloomHashes = 32
+)
+
+// bloomFilter is the bloom filter backend, registered as "bloom". It takes
+// fewer bits per element than a cuckoo filter at false positive rates above
+// about 0.5%, and its inserts never fail, but it cannot delete. Once it holds
+// more than its capacity, its false positive rate rises past the target.
+//
+// Each element sets hashes bits, picked by double hashing the two halves of
+// its 128 bit metro hash. The bits are packed into 64 bit words.
+type bloomFilter struct {
+	words  []uint64
+	bits   uint64
+	hashes uint
+	// ones counts the set bits and count the inserts.
+	ones  uint64
+	count uint
+	// undo records how to restore the words set while it is set.
+	undo *undoLog
+}
+
+// bloomLayout returns the number of bits and hashes of a bloom filter of
+// capacity elements with a false positive rate of fpr.
+func bloomLayout(capacity uint64, fpr float64) (uint64, uint) {
+	if capacity == 0 {
+		capacity = 1
+	}
+	m := math.Ceil(float64(capacity) * -math.Log(fpr) / (math.Ln2 * math.Ln2))
+	words := (uint64(m) + 63) / 64
+	k := uint(math.Round(float64(64*words) / float64(capacity) * math.Ln2))
+	switch {
+	case k < 1:
+		k = 1
+	case k > maxBloomHashes:
+		k = maxBloomHashes
+	}
+	return 64 * words, k
+}
+
+func newBloomFilter(config filterConfig) *bloomFilter {
+	m, k := bloomLayout(config.Capacity, config.FalsePositiveRate)
+	return &bloomFilter{words: make([]uint64, m/64), bits: m, hashes: k}
+}
+
+func decodeBloomFilter(b []byte, hashes uint) (*bloomFilter, error) {
+	if len(b) == 0 || len(b)%8 != 0 {
+		return nil, errors.New("bloom filter data is not a whole number of words")
+	}
+	if hashes < 1 || hashes > maxBloomHashes {
+		return nil, fmt.Errorf("bloom filter has %d hashes", hashes)
+	}
+	bf := &bloomFilter{words: make([]uint64, len(b)/8), bits: uint64(len(b)) * 8, hashes: hashes}
+	for i := range bf.words {
+		bf.words[i] = binary.LittleEndian.Uint64(b[8*i:])
+		bf.ones += uint64(bits.OnesCount64(bf.words[i]))
+	}
+	return bf, nil
+}
+
+// Insert sets the bits of data. It always succeeds.
+func (bf *bloomFilter) Insert(data []byte) bool {
+	h1, h2 := metro.Hash128(data, hashSeed)
+	for i := uint(0); i < bf.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % bf.bits
+		if w, mask := bit/64, uint64(1)<<(bit%64); bf.words[w]&mask == 0 {
+			if bf.undo != nil {
+				bf.undo.record(func() { bf.words[w] &^= mask })
+			}
+			bf.words[w] |= mask
+			bf.ones++
+		}
+	}
+	bf.count++
+	return true
+}
+
+// InsertUntil inserts data. Bloom filters do not support expiries, which
+// the server rejects before inserting.
+func (bf *bloomFilter) InsertUntil(data []byte, expiry uint32) bool {
+	return bf.Insert(data)
+}
+
+// InsertValue inserts data. Bloom filters store no values.
+func (bf *bloomFilter) InsertValue(data []byte, value uint16, expiry uint32) bool {
+	return bf.Insert(data)
+}
+
+// InsertHash fails: bloom filters hash elements with a 128 bit hash, which
+// the hash of an element does not give.
+func (bf *bloomFilter) InsertHash(hash uint64, expiry uint32) bool {
+	return false
+}
+
+func (bf *bloomFilter) LookupHash(hash uint64) bool {
+	return false
+}
+
+func (bf *bloomFilter) Value(data []byte) (uint16, bool) {
+	return 0, false
+}
+
+// Delete fails: bits may be shared with other elements.
+func (bf *bloomFilter) Delete(data []byte) bool {
+	return false
+}
+
+func (bf *bloomFilter) Lookup(data []byte) bool {
+	h1, h2 := metro.Hash128(data, hashSeed)
+	for i := uint(0); i < bf.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % bf.bits
+		if bf.words[bit/64]&(uint64(1)<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Occurrences returns 1 if data may have been inserted and 0 otherwise.
+func (bf *bloomFilter) Occurrences(data []byte) uint {
+	if bf.Lookup(data) {
+		return 1
+	}
+	return 0
+}
+
+// Count returns the number of inserts, repeated ones included.
+func (bf *bloomFilter) Count() uint {
+	return bf.count
+}
+
+func (bf *bloomFilter) Reset() {
+	if bf.undo != nil {
+		words, ones, count := append([]uint64(nil), bf.words...), bf.ones, bf.count
+		bf.undo.record(func() {
+			copy(bf.words, words)
+			bf.ones, bf.count = ones, count
+		})
+	}
+	for i := range bf.words {
+		bf.words[i] = 0
+	}
+	bf.ones, bf.count = 0, 0
+}
+
+func (bf *bloomFilter) Sweep(now uint32) int {
+	return 0
+}
+
+// falsePositiveRate estimates the false positive rate from the share of
+// set bits.
+func (bf *bloomFilter) falsePositiveRate() float64 {
+	return math.Pow(float64(bf.ones)/float64(bf.bits), float64(bf.hashes))
+}
+
+func init() {
+	registerBackend("bloom", &backendType{
+		check: checkBloomConfig,
+		new: func(config filterConfig) backend {
+			return newBloomFilter(config)
+		},
+		decode: decodeBloomSnapshot,
+	})
+}
+
+// checkBloomConfig rejects the settings of cuckoo filters and capacities
+// too large to allocate, and picks the default false positive rate.
+func checkBloomConfig(config *filterConfig) *errdetails.BadRequest {
+	switch {
+	case config.Growth != 0:
+		return badRequest("grow", "bloom filters cannot grow")
+	case config.Window != 0:
+		return badRequest("window", "bloom filters cannot be windowed")
+	case config.Counting:
+		return badRequest("counting", "bloom filters cannot count")
+	case config.FingerprintBits != 0:
+		return badRequest("fingerprint_bits", "bloom filters have no fingerprints")
+	case config.BucketSize != 0:
+		return badRequest("bucket_size", "bloom filters have no buckets")
+	case config.ValueBits != 0:
+		return badRequest("value_bits", "bloom filters cannot store values")
+	case config.FalsePositiveRate == 0:
+		config.FalsePositiveRate = defaultBloomFalsePositiveRate
+	case config.FalsePositiveRate < minBloomFalsePositiveRate:
+		return badRequest("false_positive_rate", fmt.Sprintf("false positive rate must be at least %g", minBloomFalsePositiveRate))
+	}
+	// A filter takes at least a bit per element, which bounds the capacity
+	// before bloomLayout can overflow. Its snapshot must stay importable.
+	if config.Capacity > 8*maxImportSize {
+		return badRequest("capacity", fmt.Sprintf("capacity must fit in %d bytes of bits", maxImportSize))
+	}
+	if m, _ := bloomLayout(config.Capacity, config.FalsePositiveRate); m/8 > maxImportSize {
+		return badRequest("capacity", fmt.Sprintf("capacity must fit in %d bytes of bits", maxImportSize))
+	}
+	return nil
+}
+
+func (bf *bloomFilter) supports(c capability) bool {
+	return c == canInsert || c == canReset
+}
+
+func (bf *bloomFilter) snapshot(h *snapshotHeader) []byte {
+	h.Count = bf.count
+	h.Hashes = bf.hashes
+	b := make([]byte, 8*len(bf.words))
+	for i, w := range bf.words {
+		binary.LittleEndian.PutUint64(b[8*i:], w)
+	}
+	return b
+}
+
+func decodeBloomSnapshot(h *snapshotHeader, payload []byte) (backend, error) {
+	bf, err := decodeBloomFilter(payload, h.Hashes)
+	if err != nil {
+		return nil, err
+	}
+	bf.count = h.Count
+	return bf, nil
+}
+
+// info reports the load factor as the share of the capacity inserted.
+func (bf *bloomFilter) info(info *pb.FilterInfo) {
+	info.MemoryBytes = 8 * uint64(len(bf.words))
+	if info.Capacity != 0 {
+		info.LoadFactor = float64(info.Count) / float64(info.Capacity)
+	}
+	info.EstimatedFalsePositiveRate = bf.falsePositiveRate()
+	info.HashFunctions = uint32(bf.hashes)
+}
+
+func (bf *bloomFilter) annotate(rec *walRecord) {}
+
+// journal makes bf record in u how to undo its inserts and resets until u
+// stops.
+func (bf *bloomFilter) journal(u *undoLog) {
+	ones, count := bf.ones, bf.count
+	u.record(func() { bf.ones, bf.count = ones, count })
+	bf.undo = u
+	u.onStop(func() { bf.undo = nil })
+}
+
+// replay never fails, as bloom filter inserts always succeed.
+func (bf *bloomFilter) replay(rec *walRecord) int {
+	switch rec.op {
+	case opInsertElements:
+		for _, e := range rec.elements {
+			bf.Insert(e)
+		}
+	case opResetFilter:
+		bf.Reset()
+	}
+	return 0
+}
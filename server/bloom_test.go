@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBloomFilter(t *testing.T) {
+	for _, fpr := range []float64{0.1, 0.01, 0.001} {
+		bf := newBloomFilter(filterConfig{Capacity: 10000, FalsePositiveRate: fpr})
+		for i := 0; i < 10000; i++ {
+			assert.True(t, bf.Insert([]byte(strconv.Itoa(i))), i)
+		}
+		for i := 0; i < 10000; i++ {
+			assert.True(t, bf.Lookup([]byte(strconv.Itoa(i))), i)
+		}
+		hits := 0
+		for i := 10000; i < 210000; i++ {
+			if bf.Lookup([]byte(strconv.Itoa(i))) {
+				hits++
+			}
+		}
+		assert.InEpsilon(t, fpr, float64(hits)/200000, 0.25, fpr)
+		assert.InEpsilon(t, fpr, bf.falsePositiveRate(), 0.25, fpr)
+		assert.Equal(t, uint(10000), bf.Count())
+
+		decoded, err := decodeBloomFilter(bf.snapshot(new(snapshotHeader)), bf.hashes)
+		assert.NoError(t, err)
+		assert.Equal(t, bf.words, decoded.words)
+		assert.Equal(t, bf.ones, decoded.ones)
+
+		bf.Reset()
+		assert.Zero(t, bf.Count())
+		assert.False(t, bf.Lookup([]byte("0")))
+	}
+
+}
+
+func TestBloomFilterOverCapacity(t *testing.T) {
+	// Inserts past the capacity still succeed.
+	bf := newBloomFilter(filterConfig{Capacity: 10, FalsePositiveRate: 0.01})
+	for i := 0; i < 1000; i++ {
+		assert.True(t, bf.Insert([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Greater(t, bf.falsePositiveRate(), 0.5)
+}
+
+func TestDecodeBloomFilterCorrupt(t *testing.T) {
+	_, err := decodeBloomFilter(make([]byte, 12), 3)
+	assert.Error(t, err)
+	_, err = decodeBloomFilter(make([]byte, 16), 0)
+	assert.Error(t, err)
+}
+
+func TestCreateBloomFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000, Backend: "bloom", FalsePositiveRate: 0.001})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"mary", "rose"}})
+	assert.NoError(t, err)
+	assert.NoError(t, s.Dump(dir))
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "lucy"})
+	assert.NoError(t, err)
+
+	// The filter survives a snapshot and a replay of the log.
+	s = restart(t, s, dir)
+	for _, e := range []string{"jack", "mary", "rose", "lucy"} {
+		assert.True(t, found(s, "aaa", e), e)
+	}
+	assert.False(t, found(s, "aaa", "tom"))
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(4), count.Len)
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, "bloom", res.Info.Backend)
+	assert.Equal(t, uint32(10), res.Info.HashFunctions)
+	assert.Equal(t, 0.001, res.Info.TargetFalsePositiveRate)
+	assert.Equal(t, uint64(1800), res.Info.MemoryBytes)
+	assert.Equal(t, 0.004, res.Info.LoadFactor)
+
+	_, err = s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "aaa"})
+	assert.NoError(t, err)
+	assert.False(t, found(s, "aaa", "jack"))
+}
+
+func TestBloomFilterUnsupported(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000, Backend: "bloom"})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+
+	// Deletes and ttls fail without touching the filter.
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "tom", Ttl: durationpb.New(time.Hour)})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"tom"}, Ttl: durationpb.New(time.Hour)})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.False(t, found(s, "aaa", "tom"))
+}
+
+func TestCreateBloomFilterInvalidConfig(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", Grow: true},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", Window: durationpb.New(time.Hour)},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", Counting: true},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", FingerprintBits: 8},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", BucketSize: 2},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", ValueBits: 8},
+		{FilterName: "bbb", Capacity: 10, Backend: "bloom", FalsePositiveRate: 1e-12},
+		{FilterName: "bbb", Capacity: 1 << 62, Backend: "bloom"},
+		{FilterName: "bbb", Capacity: 1 << 30, Backend: "bloom", FalsePositiveRate: 1e-9},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err), req.String())
+	}
+}
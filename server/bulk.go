@@ -0,0 +1,129 @@
+package server
+
+import (
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"io"
+)
+
+// defaultAckWindow is the number of elements an InsertElementsStream response
+// acknowledges when the stream does not set ack_window.
+const defaultAckWindow = 1000
+
+// InsertElementsStream inserts the elements of a stream in windows of
+// ack_window elements. Each window is inserted under one lock and logged as
+// one record, then acknowledged before the next is read, so a client that
+// stops reading acknowledgements stops the stream through gRPC flow control.
+// The elements left when the client closes its side form a last, smaller
+// window.
+func (s *cuckooFilterServer) InsertElementsStream(stream pb.CuckooFilter_InsertElementsStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	name := first.FilterName
+	filter, ok := s.filters.get(name)
+	if !ok {
+		return s.insertStreamFailed(stream, StatusNoFilterFound, name)
+	}
+	if !filter.cf.supports(canInsert) {
+		return s.insertStreamFailed(stream, StatusUnsupported, name, unsupported(filter, canInsert))
+	}
+	expiry, bad := expiryOf(first.Ttl)
+	if bad != nil {
+		return s.insertStreamFailed(stream, StatusInvalidTTL, name, bad)
+	}
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return s.insertStreamFailed(stream, StatusUnsupported, name, unsupported(filter, canExpire))
+	}
+	window := int(first.AckWindow)
+	if window == 0 {
+		window = defaultAckWindow
+	}
+	if window > maxElementCount {
+		return s.insertStreamFailed(stream, StatusOverLimitation, name, overLimitation("ack_window"))
+	}
+
+	batch := make([]*pb.InsertElementsStreamRequest, 0, window)
+	for req := first; ; {
+		if batch = append(batch, req); len(batch) == window {
+			if err := s.insertWindow(stream, filter, name, expiry, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	return s.insertWindow(stream, filter, name, expiry, batch)
+}
+
+// insertWindow inserts a window of streamed elements and acknowledges it.
+// Elements that are invalid or do not fit are listed as failures without
+// failing the stream. Once the filter has been deleted, the stream fails
+// with FILTER_NOT_FOUND instead, even if a filter of the same name replaced
+// it.
+func (s *cuckooFilterServer) insertWindow(stream pb.CuckooFilter_InsertElementsStreamServer, filter *filter, name string, expiry uint32, batch []*pb.InsertElementsStreamRequest) error {
+	var failures []*pb.InsertFailure
+	var deleted bool
+	err := s.update(filter, func() *walRecord {
+		if deleted = filter.deleted; deleted {
+			return nil
+		}
+		inserted := make([][]byte, 0, len(batch))
+		values := make([]uint32, 0, len(batch))
+		failed := 0
+		for _, req := range batch {
+			element, invalid := elementOf(req.Element, req.RawElement)
+			if invalid != nil {
+				failures = append(failures, &pb.InsertFailure{Sequence: req.Sequence, Status: StatusInvalidElement})
+				continue
+			}
+			if st, _ := checkValues(filter, "value", req.Value); st != nil {
+				failures = append(failures, &pb.InsertFailure{Sequence: req.Sequence, Status: st})
+				continue
+			}
+			if !filter.insert(element, req.Value, expiry) {
+				failures = append(failures, &pb.InsertFailure{Sequence: req.Sequence, Status: StatusInsertionFailed})
+				failed++
+				continue
+			}
+			inserted = append(inserted, element)
+			values = append(values, req.Value)
+		}
+		filter.stats.inserted(len(inserted), failed)
+		if len(inserted) == 0 {
+			return nil
+		}
+		return &walRecord{op: opInsertElements, name: name, elements: inserted, expiry: expiry, values: filter.logValues(values...)}
+	})
+	if err != nil {
+		return s.insertStreamFailed(stream, StatusLogFailed, name, logFailure(err))
+	}
+	if deleted {
+		return s.insertStreamFailed(stream, StatusNoFilterFound, name)
+	}
+	return stream.Send(&pb.InsertElementsStreamResponse{
+		Status:       StatusOK,
+		LastSequence: batch[len(batch)-1].Sequence,
+		Count:        uint64(len(batch)),
+		Failures:     failures,
+	})
+}
+
+func (s *cuckooFilterServer) insertStreamFailed(stream pb.CuckooFilter_InsertElementsStreamServer, st *pb.Status, filterName string, details ...proto.Message) error {
+	if err := s.fail(st, filterName, details...); err != nil {
+		return err
+	}
+	return stream.Send(&pb.InsertElementsStreamResponse{Status: st})
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// recvAll receives the responses of stream until it ends.
+func recvAll(t *testing.T, stream pb.CuckooFilter_InsertElementsStreamClient) []*pb.InsertElementsStreamResponse {
+	var acks []*pb.InsertElementsStreamResponse
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return acks
+		}
+		if !assert.NoError(t, err) {
+			return acks
+		}
+		acks = append(acks, res)
+	}
+}
+
+func TestInsertElementsStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	c := newTestClient(t, s)
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10000})
+	assert.NoError(t, err)
+
+	stream, err := c.InsertElementsStream(ctx)
+	assert.NoError(t, err)
+	for i := 0; i < 2500; i++ {
+		req := &pb.InsertElementsStreamRequest{Sequence: uint64(100 + i), Element: strconv.Itoa(i)}
+		if i == 0 {
+			req.FilterName = "aaa"
+		}
+		assert.NoError(t, stream.Send(req))
+	}
+	assert.NoError(t, stream.CloseSend())
+
+	// The last window holds the elements left when the stream closed.
+	acks := recvAll(t, stream)
+	if assert.Len(t, acks, 3) {
+		for i, want := range []struct{ last, count uint64 }{{1099, 1000}, {2099, 1000}, {2599, 500}} {
+			assert.Equal(t, want.last, acks[i].LastSequence)
+			assert.Equal(t, want.count, acks[i].Count)
+			assert.Empty(t, acks[i].Failures)
+		}
+	}
+
+	s = restart(t, s, dir)
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(2500), count.Len)
+	assert.True(t, found(s, "aaa", "2499"))
+}
+
+func TestInsertElementsStreamInvalid(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	c := newTestClient(t, s)
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, err)
+
+	// Invalid elements are listed by sequence and do not end the stream.
+	stream, err := c.InsertElementsStream(ctx)
+	assert.NoError(t, err)
+	for _, req := range []*pb.InsertElementsStreamRequest{
+		{FilterName: "aaa", AckWindow: 4, Element: "jack"},
+		{Sequence: 1, Element: "mary", RawElement: []byte("mary")},
+		{Sequence: 2, Element: "rose", Value: 1},
+		{Sequence: 3, RawElement: []byte("lucy")},
+	} {
+		assert.NoError(t, stream.Send(req))
+	}
+	assert.NoError(t, stream.CloseSend())
+	acks := recvAll(t, stream)
+	if assert.Len(t, acks, 1) && assert.Len(t, acks[0].Failures, 2) {
+		assert.Equal(t, uint64(1), acks[0].Failures[0].Sequence)
+		assert.Equal(t, StatusInvalidElement.Code, acks[0].Failures[0].Status.Code)
+		assert.Equal(t, uint64(2), acks[0].Failures[1].Sequence)
+		assert.Equal(t, StatusUnsupported.Code, acks[0].Failures[1].Status.Code)
+	}
+	for e, want := range map[string]bool{"jack": true, "mary": false, "rose": false, "lucy": true} {
+		assert.Equal(t, want, found(s, "aaa", e), e)
+	}
+}
+
+func TestInsertElementsStreamFull(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := newTestClient(t, NewServer())
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 4})
+	assert.NoError(t, err)
+
+	// Elements that do not fit are listed by sequence and do not end the
+	// stream.
+	stream, err := c.InsertElementsStream(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.InsertElementsStreamRequest{FilterName: "aaa", AckWindow: 100, Element: "0"}))
+	for i := 1; i < 100; i++ {
+		assert.NoError(t, stream.Send(&pb.InsertElementsStreamRequest{Sequence: uint64(i), Element: strconv.Itoa(i)}))
+	}
+	res, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), res.Count)
+	assert.NotEmpty(t, res.Failures)
+	for _, f := range res.Failures {
+		assert.Equal(t, StatusInsertionFailed.Code, f.Status.Code)
+	}
+	count, _ := c.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(100-len(res.Failures)), count.Len)
+	assert.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestInsertElementsStreamFailures(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := newTestClient(t, NewServer())
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 4})
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		req    *pb.InsertElementsStreamRequest
+		reason string
+	}{
+		{&pb.InsertElementsStreamRequest{FilterName: "bbb", Element: "jack"}, "FILTER_NOT_FOUND"},
+		{&pb.InsertElementsStreamRequest{FilterName: "aaa", Element: "jack", AckWindow: maxElementCount + 1}, "TOO_MANY_ELEMENTS"},
+		{&pb.InsertElementsStreamRequest{FilterName: "aaa", Element: "jack", Ttl: durationpb.New(-time.Second)}, "INVALID_TTL"},
+	} {
+		stream, err := c.InsertElementsStream(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(tc.req))
+		_, err = stream.Recv()
+		assert.Equal(t, tc.reason, errorReason(err))
+	}
+}
+
+func TestInsertElementsStreamDeletedFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	c := newTestClient(t, s)
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, err)
+	stream, err := c.InsertElementsStream(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.InsertElementsStreamRequest{FilterName: "aaa", AckWindow: 1, Element: "jack"}))
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	// Windows after the filter was deleted are not acknowledged, even once
+	// a filter of the same name exists again.
+	_, err = c.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "aaa"})
+	assert.NoError(t, err)
+	_, err = c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.InsertElementsStreamRequest{Sequence: 1, Element: "mary"}))
+	_, err = stream.Recv()
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+	assert.False(t, found(s, "aaa", "mary"))
+}
@@ -0,0 +1,617 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"time"
+)
+
+const (
+	// growAt is the load factor at which a growing chain starts a new
+	// sub-filter. Inserts into fuller filters relocate many fingerprints
+	// before they fail, so chains grow well before that happens.
+	growAt = 0.9
+	// defaultGrowth is the growth factor of filters created to grow without
+	// one.
+	defaultGrowth = 2
+	// maxGrowth bounds the growth factor.
+	maxGrowth = 16
+	// defaultWindowSlices is the number of slices of windowed filters
+	// created without one.
+	defaultWindowSlices = 8
+	// maxWindowSlices bounds the number of slices.
+	maxWindowSlices = 256
+)
+
+// timeNow is the clock of windowed chains.
+var timeNow = time.Now
+
+var errTruncatedChain = errors.New("filter data does not match the sizes of its sub-filters")
+
+// chain is the cuckoo filter backend, registered as defaultBackend. A fixed size filter is a chain
+// of a single cuckooFilter. A growing chain starts a sub-filter growth times
+// larger than the last one whenever that one gets full, so inserts keep
+// succeeding: lookups check every sub-filter, inserts go to the newest one
+// and deletes remove the element from the newest sub-filter holding it.
+// Every sub-filter has the same layout.
+//
+// A windowed chain instead holds one sub-filter per slice of time, numbered
+// by the slices elapsed since the Unix epoch. Inserts go to the sub-filter
+// of the current slice, started when the first insert of the slice arrives.
+// A slice expires once its newest element is older than the window, so an
+// element stays for at least the window and at most one slice longer, and
+// lookups skip expired slices until the next insert drops them. A new or
+// reset windowed chain holds a single sub-filter of slice zero, which has
+// long expired.
+type chain struct {
+	filters []*cuckooFilter
+	growth  uint64
+	// window is the config of a windowed chain and slices the slice number
+	// of each sub-filter.
+	window filterConfig
+	slices []int64
+}
+
+func newChain(config filterConfig) *chain {
+	bits, bucketSize := config.layout()
+	capacity := config.Capacity
+	if config.Window != 0 {
+		capacity = (capacity + config.Slices - 1) / config.Slices
+	}
+	cf := newCuckooFilter(bucketCount(capacity, bucketSize), bits, bucketSize)
+	if config.Counting {
+		cf.enableCounting()
+	}
+	if config.ValueBits != 0 {
+		cf.enableValues(config.ValueBits)
+	}
+	c := &chain{
+		filters: []*cuckooFilter{cf},
+		growth:  config.Growth,
+	}
+	if config.Window != 0 {
+		c.window = config
+		c.slices = []int64{0}
+	}
+	return c
+}
+
+func (c *chain) windowed() bool {
+	return c.window.Window != 0
+}
+
+// currentSlice returns the number of the slice of time it is now.
+func (c *chain) currentSlice() int64 {
+	return timeNow().UnixNano() / c.window.sliceLength()
+}
+
+// lastSlice returns the slice of the sub-filter inserts currently go to.
+func (c *chain) lastSlice() int64 {
+	if !c.windowed() {
+		return 0
+	}
+	return c.slices[len(c.slices)-1]
+}
+
+// live returns the sub-filters that have not expired.
+func (c *chain) live() []*cuckooFilter {
+	if !c.windowed() {
+		return c.filters
+	}
+	oldest := c.currentSlice() - int64(c.window.Slices)
+	for i, slice := range c.slices {
+		if slice >= oldest {
+			return c.filters[i:]
+		}
+	}
+	return nil
+}
+
+// advance makes slice the current slice of a windowed chain, dropping the
+// sub-filters that expired by then. It never moves back, so inserts made
+// after the clock stepped back still go to the newest slice.
+func (c *chain) advance(slice int64) {
+	if !c.windowed() || slice <= c.lastSlice() {
+		return
+	}
+	last := c.last()
+	oldest := slice - int64(c.window.Slices)
+	expired := 0
+	for expired < len(c.slices) && c.slices[expired] < oldest {
+		expired++
+	}
+	// An expired sub-filter is reused for the new slice.
+	var cf *cuckooFilter
+	if expired > 0 {
+		cf = c.filters[0]
+		cf.Reset()
+	} else {
+		cf = last.sibling(last.buckets)
+	}
+	c.filters = append(c.filters[expired:], cf)
+	c.slices = append(c.slices[expired:], slice)
+}
+
+// grow appends an empty sub-filter growth times the size of the last one.
+func (c *chain) grow() {
+	last := c.last()
+	// Filters have a power of two buckets, so the size is rounded up.
+	buckets := uint64(1)
+	for buckets < last.buckets*c.growth {
+		buckets <<= 1
+	}
+	c.filters = append(c.filters, last.sibling(buckets))
+}
+
+func (c *chain) last() *cuckooFilter {
+	return c.filters[len(c.filters)-1]
+}
+
+// buckets returns the bucket count of every sub-filter.
+func (c *chain) buckets() []uint64 {
+	buckets := make([]uint64, len(c.filters))
+	for i, cf := range c.filters {
+		buckets[i] = cf.buckets
+	}
+	return buckets
+}
+
+func (c *chain) Lookup(data []byte) bool {
+	return c.LookupHash(pb.HashElement(data))
+}
+
+// LookupHash reports whether the live sub-filters hold the element of hash.
+func (c *chain) LookupHash(hash uint64) bool {
+	for _, cf := range c.live() {
+		if cf.occurrencesOf(hash) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Occurrences returns how many times data was inserted into the live
+// sub-filters and not deleted or expired.
+func (c *chain) Occurrences(data []byte) uint {
+	hash := pb.HashElement(data)
+	var n uint
+	for _, cf := range c.live() {
+		n += cf.occurrencesOf(hash)
+	}
+	return n
+}
+
+func (c *chain) Insert(data []byte) bool {
+	return c.InsertUntil(data, 0)
+}
+
+// InsertUntil inserts data to expire at expiry, or never if it is zero.
+func (c *chain) InsertUntil(data []byte, expiry uint32) bool {
+	if c.windowed() {
+		c.advance(c.currentSlice())
+	}
+	return c.insertEntry(pb.HashElement(data), entry{expiry: expiry, n: 1})
+}
+
+// InsertHash inserts the element of hash to expire at expiry, or never if
+// it is zero. A value chain stores a new element with a zero value and keeps
+// the value of one it holds, renewing its expiry.
+func (c *chain) InsertHash(hash uint64, expiry uint32) bool {
+	if c.windowed() {
+		c.advance(c.currentSlice())
+	}
+	return c.insertHash(hash, expiry)
+}
+
+// insertHash inserts the element of hash into the newest sub-filter,
+// whatever the time.
+func (c *chain) insertHash(hash uint64, expiry uint32) bool {
+	if c.filters[0].values != nil {
+		for i := len(c.filters) - 1; i >= 0; i-- {
+			if c.filters[i].Renew(hash, expiry) {
+				return true
+			}
+		}
+	}
+	return c.insertEntry(hash, entry{expiry: expiry, n: 1})
+}
+
+func (c *chain) insertEntry(hash uint64, e entry) bool {
+	if c.growth == 0 {
+		return c.last().insert(hash, e)
+	}
+	if c.last().LoadFactor() >= growAt {
+		c.grow()
+	}
+	if c.last().insert(hash, e) {
+		return true
+	}
+	c.grow()
+	return c.last().insert(hash, e)
+}
+
+// InsertValue inserts data with value to expire at expiry, or never if it
+// is zero. Inserting data again replaces its value and expiry in whichever
+// sub-filter holds it. Value chains are never windowed.
+func (c *chain) InsertValue(data []byte, value uint16, expiry uint32) bool {
+	return c.insertValue(pb.HashElement(data), value, expiry)
+}
+
+func (c *chain) insertValue(hash uint64, value uint16, expiry uint32) bool {
+	for i := len(c.filters) - 1; i >= 0; i-- {
+		if c.filters[i].Replace(hash, value, expiry) {
+			return true
+		}
+	}
+	return c.insertEntry(hash, entry{expiry: expiry, n: 1, value: value})
+}
+
+// Value returns the value of data, if the chain holds it unexpired.
+func (c *chain) Value(data []byte) (uint16, bool) {
+	for i := len(c.filters) - 1; i >= 0; i-- {
+		if value, ok := c.filters[i].Value(data); ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// Delete removes data from the newest live sub-filter holding it unexpired.
+// Expired slices and elements are left alone, as lookups no longer find them.
+func (c *chain) Delete(data []byte) bool {
+	return c.delete(pb.HashElement(data), expiryNow())
+}
+
+// delete removes the element of hash unexpired by now, or expired or not if
+// now is zero, from the newest live sub-filter holding it.
+func (c *chain) delete(hash uint64, now uint32) bool {
+	live := c.live()
+	for i := len(live) - 1; i >= 0; i-- {
+		if live[i].delete(hash, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chain) Count() uint {
+	var count uint
+	for _, cf := range c.live() {
+		count += cf.Count()
+	}
+	return count
+}
+
+// Sweep removes the elements that expired by now and returns how many.
+func (c *chain) Sweep(now uint32) int {
+	swept := 0
+	for _, cf := range c.filters {
+		swept += cf.Sweep(now)
+	}
+	return swept
+}
+
+// expiring lists the sub-filters that hold expiries.
+func (c *chain) expiring() []int {
+	var expiring []int
+	for i, cf := range c.filters {
+		if cf.expiry != nil {
+			expiring = append(expiring, i)
+		}
+	}
+	return expiring
+}
+
+// stored counts the fingerprints of every sub-filter, expired or not.
+func (c *chain) stored() uint {
+	var count uint
+	for _, cf := range c.filters {
+		count += cf.Count()
+	}
+	return count
+}
+
+// Reset empties the chain and drops every sub-filter but the first.
+func (c *chain) Reset() {
+	c.filters = c.filters[:1]
+	c.filters[0].Reset()
+	if c.windowed() {
+		c.slices = append(c.slices[:0], 0)
+	}
+}
+
+// Encode returns the sub-filters encoded back to back, followed by the
+// expiries of the sub-filters that hold any, for a counting chain the counts
+// of every sub-filter and for a value chain their values.
+func (c *chain) Encode() []byte {
+	var size int
+	for _, cf := range c.filters {
+		size += cf.size + 4*len(cf.expiry) + 2*len(cf.counts) + cf.valuesSize()
+	}
+	b := make([]byte, 0, size)
+	for _, cf := range c.filters {
+		b = append(b, cf.table[:cf.size]...)
+	}
+	for _, cf := range c.filters {
+		b = cf.appendExpiry(b)
+	}
+	for _, cf := range c.filters {
+		b = cf.appendCounts(b)
+	}
+	for _, cf := range c.filters {
+		b = cf.appendValues(b)
+	}
+	return b
+}
+
+// decodeChain decodes the output of Encode, given the bucket count of every
+// sub-filter, the sub-filters holding expiries and, for a windowed chain,
+// their slices.
+func decodeChain(b []byte, buckets []uint64, expiring []int, slices []int64, config filterConfig) (*chain, error) {
+	bits, bucketSize := config.layout()
+	bucketBytes := uint64(bits * bucketSize / 8)
+	c := &chain{growth: config.Growth}
+	if config.Window != 0 {
+		if len(slices) != len(buckets) {
+			return nil, errors.New("filter data does not list the slice of every sub-filter")
+		}
+		c.window, c.slices = config, slices
+	}
+	for _, n := range buckets {
+		if n > uint64(len(b))/bucketBytes {
+			return nil, errTruncatedChain
+		}
+		size := n * bucketBytes
+		cf, err := decodeCuckooFilter(b[:size], bits, bucketSize)
+		if err != nil {
+			return nil, err
+		}
+		c.filters = append(c.filters, cf)
+		b = b[size:]
+	}
+	for k, i := range expiring {
+		if i < 0 || i >= len(c.filters) || k > 0 && i <= expiring[k-1] {
+			return nil, errTruncatedChain
+		}
+		cf := c.filters[i]
+		size := 4 * cf.buckets * uint64(cf.bucketSize)
+		if size > uint64(len(b)) {
+			return nil, errTruncatedChain
+		}
+		cf.decodeExpiry(b[:size])
+		b = b[size:]
+	}
+	if config.Counting {
+		for _, cf := range c.filters {
+			size := 2 * cf.buckets * uint64(cf.bucketSize)
+			if size > uint64(len(b)) {
+				return nil, errTruncatedChain
+			}
+			if err := cf.decodeCounts(b[:size]); err != nil {
+				return nil, err
+			}
+			b = b[size:]
+		}
+	}
+	if config.ValueBits != 0 {
+		for _, cf := range c.filters {
+			size := uint64(valuesSize(cf.buckets*uint64(cf.bucketSize), config.ValueBits))
+			if size > uint64(len(b)) {
+				return nil, errTruncatedChain
+			}
+			if err := cf.decodeValues(b[:size], config.ValueBits); err != nil {
+				return nil, err
+			}
+			b = b[size:]
+		}
+	}
+	if len(b) != 0 || len(c.filters) == 0 {
+		return nil, errTruncatedChain
+	}
+	return c, nil
+}
+
+func init() {
+	registerBackend(defaultBackend, &backendType{
+		check: checkChainConfig,
+		new: func(config filterConfig) backend {
+			return newChain(config)
+		},
+		decode: decodeChainSnapshot,
+	})
+}
+
+// checkChainConfig checks the layout of a cuckoo filter and picks the
+// fingerprint size of a false positive rate.
+func checkChainConfig(config *filterConfig) *errdetails.BadRequest {
+	if config.FalsePositiveRate != 0 && config.FingerprintBits != 0 {
+		return badRequest("false_positive_rate", "false positive rate and fingerprint bits are mutually exclusive")
+	}
+	if bad := checkLayout(*config); bad != nil {
+		return bad
+	}
+	if config.FalsePositiveRate != 0 {
+		_, bucketSize := config.layout()
+		bits, err := fingerprintBitsFor(config.FalsePositiveRate, bucketSize)
+		if err != nil {
+			return badRequest("false_positive_rate", err.Error())
+		}
+		config.FingerprintBits = bits
+	}
+	return checkCapacity(*config)
+}
+
+// maxTableSize bounds the fingerprints a cuckoo filter is created with, so
+// that its snapshot stays within what ImportFilter accepts.
+const maxTableSize = maxImportSize
+
+// checkCapacity rejects capacities whose fingerprints would take more than
+// maxTableSize bytes.
+func checkCapacity(config filterConfig) *errdetails.BadRequest {
+	bits, bucketSize := config.layout()
+	// Each element takes a slot, so the capacity is checked against the
+	// slots that fit before bucketCount can overflow.
+	if config.Capacity > maxTableSize*8/uint64(bits) ||
+		tableSize(bucketCount(config.Capacity, bucketSize), bits, bucketSize) > maxTableSize {
+		return badRequest("capacity", fmt.Sprintf("capacity must fit in %d bytes of fingerprints", maxTableSize))
+	}
+	return nil
+}
+
+// checkLayout rejects the fingerprint and bucket sizes and the values that
+// cuckoo filters do not support, where config sets them.
+func checkLayout(config filterConfig) *errdetails.BadRequest {
+	switch {
+	case config.BucketSize != 0 && !supported(bucketSizes, config.BucketSize):
+		return badRequest("bucket_size", fmt.Sprintf("bucket size must be one of %v", bucketSizes))
+	case config.FingerprintBits != 0 && !supported(fingerprintSizes, config.FingerprintBits):
+		return badRequest("fingerprint_bits", fmt.Sprintf("fingerprint bits must be one of %v", fingerprintSizes))
+	case config.ValueBits > maxValueBits:
+		return badRequest("value_bits", fmt.Sprintf("value bits must be at most %d", maxValueBits))
+	case config.ValueBits != 0 && config.Counting:
+		return badRequest("value_bits", "value filters cannot count")
+	case config.ValueBits != 0 && config.Window != 0:
+		return badRequest("value_bits", "value filters cannot be windowed")
+	}
+	return nil
+}
+
+// checkSnapshotConfig checks the config of a chain decoded from a snapshot
+// as CreateFilter checks a new one, since ImportFilter decodes snapshots
+// that clients built.
+func checkSnapshotConfig(config filterConfig) *errdetails.BadRequest {
+	if config.Growth != 0 {
+		if bad := checkGrowth(config.Growth); bad != nil {
+			return bad
+		}
+	}
+	if config.Window != 0 {
+		if bad := checkWindow(config); bad != nil {
+			return bad
+		}
+	}
+	if bad := checkLayout(config); bad != nil {
+		return bad
+	}
+	return checkCapacity(config)
+}
+
+func (c *chain) supports(want capability) bool {
+	return want != canStoreValues || c.filters[0].values != nil
+}
+
+func (c *chain) snapshot(h *snapshotHeader) []byte {
+	h.Count = c.stored()
+	h.Buckets = c.buckets()
+	h.Slices = append([]int64(nil), c.slices...)
+	h.Expiring = c.expiring()
+	return c.Encode()
+}
+
+// decodeChainSnapshot decodes a chain from a snapshot.
+func decodeChainSnapshot(h *snapshotHeader, payload []byte) (backend, error) {
+	if bad := checkSnapshotConfig(h.Config); bad != nil {
+		return nil, configError(bad)
+	}
+	// A windowed chain holds a sub-filter for each slice of the window and
+	// one for the slice in progress at most.
+	if h.Config.Window != 0 && uint64(len(h.Buckets)) > h.Config.Slices+1 {
+		return nil, fmt.Errorf("windowed filter of %d slices has %d sub-filters", h.Config.Slices, len(h.Buckets))
+	}
+	c, err := decodeChain(payload, h.Buckets, h.Expiring, h.Slices, h.Config)
+	if err != nil {
+		return nil, err
+	}
+	if c.stored() != h.Count {
+		return nil, fmt.Errorf("snapshot holds %d elements, want %d", c.stored(), h.Count)
+	}
+	return c, nil
+}
+
+func (c *chain) info(info *pb.FilterInfo) {
+	var memory uint64
+	for _, cf := range c.filters {
+		info.BucketCount += cf.buckets
+		memory += uint64(cf.size + 4*len(cf.expiry) + 2*len(cf.counts) + cf.valuesSize())
+	}
+	// A lookup misses only if it misses every live sub-filter. Count leaves
+	// out expired slices, so the load factor is that of the live ones.
+	missRate := 1.0
+	var slots uint64
+	for _, cf := range c.live() {
+		missRate *= 1 - falsePositiveRate(cf.LoadFactor(), cf.bits, cf.bucketSize)
+		slots += cf.buckets * uint64(cf.bucketSize)
+	}
+	last := c.last()
+	info.MemoryBytes = memory
+	if slots != 0 {
+		info.LoadFactor = float64(info.Count) / float64(slots)
+	}
+	info.EstimatedFalsePositiveRate = 1 - missRate
+	info.SubFilters = uint32(len(c.filters))
+	info.GrowthFactor = uint32(c.growth)
+	info.FingerprintBits = uint32(last.bits)
+	info.BucketSize = uint32(last.bucketSize)
+}
+
+// annotate makes inserts into windowed chains replay into the slice they
+// went to.
+func (c *chain) annotate(rec *walRecord) {
+	if rec.op == opInsertElements || rec.op == opInsertHashes {
+		rec.slice = c.lastSlice()
+	}
+}
+
+// journal makes c record in u how to undo its mutations until u stops.
+// Sub-filters started since are dropped on undo, so only the current ones
+// record their slots.
+func (c *chain) journal(u *undoLog) {
+	filters, slices := append([]*cuckooFilter(nil), c.filters...), append([]int64(nil), c.slices...)
+	u.record(func() { c.filters, c.slices = filters, slices })
+	for _, cf := range c.filters {
+		cf.journal(u)
+	}
+}
+
+// replay counts the logged inserts that no longer fit and the logged deletes
+// that no longer find their element. Both succeeded when they were logged.
+func (c *chain) replay(rec *walRecord) int {
+	failed := 0
+	switch rec.op {
+	case opInsertElements:
+		// Replayed inserts go to the slice they were made in rather than
+		// the current one.
+		c.advance(rec.slice)
+		for i, e := range rec.elements {
+			var ok bool
+			if rec.values != nil {
+				ok = c.InsertValue(e, rec.values[i], rec.expiry)
+			} else {
+				ok = c.insertEntry(pb.HashElement(e), entry{expiry: rec.expiry, n: 1})
+			}
+			if !ok {
+				failed++
+			}
+		}
+	case opInsertHashes:
+		c.advance(rec.slice)
+		for _, h := range rec.hashes {
+			if !c.insertHash(h, rec.expiry) {
+				failed++
+			}
+		}
+	case opDeleteElements:
+		// The element was unexpired when it was deleted, but its ttl may
+		// have run out since.
+		for _, e := range rec.elements {
+			if hash := pb.HashElement(e); !c.delete(hash, expiryNow()) && !c.delete(hash, 0) {
+				failed++
+			}
+		}
+	case opResetFilter:
+		c.Reset()
+	}
+	return failed
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestChainGrows(t *testing.T) {
+	c := newChain(filterConfig{Capacity: 100, Growth: 2})
+	for i := 0; i < 10000; i++ {
+		assert.True(t, c.Insert([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Equal(t, uint(10000), c.Count())
+	assert.Greater(t, len(c.filters), 1)
+	buckets := c.buckets()
+	for i := 1; i < len(buckets); i++ {
+		assert.Equal(t, 2*buckets[i-1], buckets[i])
+	}
+	for i := 0; i < 10000; i++ {
+		assert.True(t, c.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+
+	for i := 0; i < 10000; i += 2 {
+		assert.True(t, c.Delete([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Equal(t, uint(5000), c.Count())
+	for i := 1; i < 10000; i += 2 {
+		assert.True(t, c.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+
+	c.Reset()
+	assert.Len(t, c.filters, 1)
+	assert.Equal(t, uint(0), c.Count())
+}
+
+func TestChainFixedSize(t *testing.T) {
+	c := newChain(filterConfig{Capacity: 4})
+	failed := false
+	for i := 0; i < 100 && !failed; i++ {
+		failed = !c.Insert([]byte(strconv.Itoa(i)))
+	}
+	assert.True(t, failed)
+	assert.Len(t, c.filters, 1)
+}
+
+func TestChainEncode(t *testing.T) {
+	c := newChain(filterConfig{Capacity: 8, Growth: 4, FingerprintBits: 12})
+	for i := 0; i < 200; i++ {
+		c.Insert([]byte(strconv.Itoa(i)))
+	}
+	config := filterConfig{Growth: 4, FingerprintBits: 12}
+	decoded, err := decodeChain(c.Encode(), c.buckets(), nil, nil, config)
+	assert.NoError(t, err)
+	assert.Equal(t, c.buckets(), decoded.buckets())
+	assert.Equal(t, c.Count(), decoded.Count())
+	assert.Equal(t, c.Encode(), decoded.Encode())
+
+	_, err = decodeChain(c.Encode()[1:], c.buckets(), nil, nil, config)
+	assert.Error(t, err)
+	_, err = decodeChain(c.Encode(), c.buckets()[1:], nil, nil, config)
+	assert.Error(t, err)
+	_, err = decodeChain(c.Encode(), c.buckets(), nil, nil, filterConfig{Growth: 4})
+	assert.Error(t, err)
+	_, err = decodeChain(c.Encode(), []uint64{1 << 62}, nil, nil, config)
+	assert.Error(t, err)
+}
+
+func TestCreateGrowingFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10, Grow: true})
+	assert.NoError(t, err)
+	for i := 0; i < 1000; i++ {
+		_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: strconv.Itoa(i)})
+		assert.NoError(t, err)
+	}
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint32(2), res.Info.GrowthFactor)
+	assert.Greater(t, res.Info.SubFilters, uint32(1))
+	assert.Equal(t, uint64(1000), res.Info.Count)
+	assert.Zero(t, res.Info.Stats.FailedInserts)
+
+	// The growth mode survives a replay of the log and a snapshot.
+	s = restart(t, s, dir)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, s.Dump(dir))
+	s = restart(t, s, dir)
+	res, _ = s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint32(2), res.Info.GrowthFactor)
+	assert.Equal(t, uint64(1001), res.Info.Count)
+	for i := 0; i < 1000; i++ {
+		assert.True(t, found(s, "aaa", strconv.Itoa(i)), i)
+	}
+}
+
+func TestCreateFilterInvalidGrowth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	for _, growth := range []uint32{1, maxGrowth + 1} {
+		_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10, Grow: true, GrowthFactor: growth})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err))
+	}
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10, Grow: true, GrowthFactor: 3})
+	assert.NoError(t, err)
+}
+
+// fakeClock makes windowed chains read the time from now until the test ends.
+func fakeClock(t *testing.T, now *time.Time) {
+	timeNow = func() time.Time { return *now }
+	t.Cleanup(func() { timeNow = time.Now })
+}
+
+func TestChainWindow(t *testing.T) {
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	c := newChain(filterConfig{Capacity: 100, Window: time.Hour, Slices: 4})
+	assert.Equal(t, uint(0), c.Count())
+	for i := 0; i < 8; i++ {
+		assert.True(t, c.Insert([]byte(strconv.Itoa(i))), i)
+		now = now.Add(15 * time.Minute)
+	}
+	// An element inserted as its slice started expires after the window;
+	// the expired slice is only dropped by the next insert.
+	for i := 0; i < 8; i++ {
+		assert.Equal(t, i >= 4, c.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Equal(t, uint(4), c.Count())
+	assert.Len(t, c.filters, 5)
+
+	c.Insert([]byte("jack"))
+	assert.Len(t, c.filters, 5)
+	assert.Equal(t, uint(5), c.Count())
+	assert.Equal(t, c.currentSlice(), c.lastSlice())
+
+	now = now.Add(2 * time.Hour)
+	assert.False(t, c.Lookup([]byte("jack")))
+	assert.Zero(t, c.Count())
+	c.Insert([]byte("mary"))
+	assert.Len(t, c.filters, 1)
+	assert.True(t, c.Lookup([]byte("mary")))
+
+	// The clock stepping back keeps inserting into the newest slice.
+	now = now.Add(-time.Hour)
+	c.Insert([]byte("rose"))
+	assert.Len(t, c.filters, 1)
+	now = now.Add(time.Hour)
+	assert.True(t, c.Lookup([]byte("rose")))
+
+	c.Reset()
+	assert.Zero(t, c.Count())
+	assert.Equal(t, []int64{0}, c.slices)
+}
+
+func TestChainWindowDeleteExpired(t *testing.T) {
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	c := newChain(filterConfig{Capacity: 100, Window: time.Hour, Slices: 4})
+	c.Insert([]byte("jack"))
+	now = now.Add(2 * time.Hour)
+	assert.False(t, c.Lookup([]byte("jack")))
+	assert.False(t, c.Delete([]byte("jack")))
+	assert.Equal(t, uint(1), c.stored())
+}
+
+func TestDeleteElementsExpired(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, Window: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary"}})
+	assert.NoError(t, err)
+	now = now.Add(2 * time.Hour)
+
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.Equal(t, "ELEMENT_NOT_FOUND", errorReason(err))
+	res, err := s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "aaa", Elements: []string{"mary"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.DeleteResult{pb.DeleteResult_NOT_FOUND}, res.Results)
+}
+
+func TestWindowedFilterInfo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, Window: durationpb.New(time.Hour), WindowSlices: 2})
+	assert.NoError(t, err)
+	elements := make([]string, 30)
+	for i := range elements {
+		elements[i] = strconv.Itoa(i)
+	}
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: elements[:20]})
+	assert.NoError(t, err)
+	now = now.Add(time.Hour)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: elements[20:]})
+	assert.NoError(t, err)
+
+	// The first slice has expired but is not dropped until the next insert.
+	now = now.Add(30 * time.Minute)
+	res, err := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), res.Info.SubFilters)
+	assert.Equal(t, uint64(10), res.Info.Count)
+	f, _ := s.filters.get("aaa")
+	live := f.cf.(*chain).live()
+	assert.Len(t, live, 1)
+	assert.Equal(t, 10/float64(live[0].buckets*uint64(live[0].bucketSize)), res.Info.LoadFactor)
+}
+
+func TestCreateWindowedFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	s, dir := loggedServer(t)
+	window := durationpb.New(time.Hour)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, Window: window, WindowSlices: 2})
+	assert.NoError(t, err)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	now = now.Add(time.Hour)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+	assert.NoError(t, s.Dump(dir))
+	now = now.Add(30 * time.Minute)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "rose"})
+
+	// Slices expire by the time they were inserted in, across a snapshot
+	// and a replay of the log.
+	s = restart(t, s, dir)
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, time.Hour, res.Info.Window.AsDuration())
+	assert.Equal(t, uint32(2), res.Info.WindowSlices)
+	assert.False(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "mary"))
+	assert.True(t, found(s, "aaa", "rose"))
+
+	now = now.Add(time.Hour)
+	assert.False(t, found(s, "aaa", "mary"))
+	assert.True(t, found(s, "aaa", "rose"))
+	now = now.Add(30 * time.Minute)
+	assert.False(t, found(s, "aaa", "rose"))
+
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "bbb", Capacity: 10, Window: window, Grow: true},
+		{FilterName: "bbb", Capacity: 10, WindowSlices: 2},
+		{FilterName: "bbb", Capacity: 10, Window: window, WindowSlices: maxWindowSlices + 1},
+		{FilterName: "bbb", Capacity: 10, Window: durationpb.New(time.Second), WindowSlices: 2},
+		{FilterName: "bbb", Capacity: 10, Window: durationpb.New(-time.Hour)},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err), req.String())
+	}
+}
@@ -0,0 +1,626 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	metro "github.com/dgryski/go-metro"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// hashSeed seeds every hash the filters compute. It is the seed of
+// panmari/cuckoofilter, whose snapshots are filters of 16 bit fingerprints
+// in buckets of 4, and of the element hash clients compute for InsertHashes.
+const hashSeed = pb.HashSeed
+
+const (
+	defaultFingerprintBits = 16
+	defaultBucketSize      = 4
+	// maxKickouts bounds how many fingerprints an insert relocates before
+	// it gives up.
+	maxKickouts = 500
+)
+
+// expiryTick is the resolution of element expiries. Expiries are rounded up
+// to it, so elements never expire early.
+const expiryTick = time.Minute
+
+// expiryAt returns the expiry of an element that expires at t.
+func expiryAt(t time.Time) uint32 {
+	return uint32((t.UnixNano() + int64(expiryTick) - 1) / int64(expiryTick))
+}
+
+// expiryNow returns the expiry of elements that expired by now.
+func expiryNow() uint32 {
+	return uint32(timeNow().UnixNano() / int64(expiryTick))
+}
+
+// fingerprintSizes and bucketSizes are the supported layouts.
+var (
+	fingerprintSizes = []uint{8, 12, 16, 32}
+	bucketSizes      = []uint{2, 4, 8}
+)
+
+// maxLoad is the load factor up to which inserts into buckets of a size
+// rarely fail. Filters are sized to stay below it at their capacity.
+var maxLoad = map[uint]float64{2: 0.84, 4: 0.96, 8: 0.98}
+
+// cuckooFilter is a cuckoo filter of 2^n buckets holding bucketSize
+// fingerprints of fingerprintBits each. The fingerprints are packed little
+// endian into table, bucket after bucket, so that a filter of 16 bit
+// fingerprints in buckets of 4 has the memory layout of a panmari/cuckoofilter
+// filter. The zero fingerprint marks an empty slot.
+//
+// Unlike panmari/cuckoofilter, a failed insert leaves the filter as it was,
+// rather than losing the last fingerprint it had to relocate.
+//
+// Once an element is inserted with an expiry, expiry holds the expiry of
+// every slot, or zero for fingerprints that never expire. Lookups skip
+// expired fingerprints, but they keep their slot and count until Sweep.
+//
+// A counting filter keeps in counts how many times the fingerprint of each
+// slot was inserted, so that inserting an element again takes no slot. count
+// counts occupied slots either way. A value filter keeps in values the value
+// of each slot, packed at valueBits bits like the table, and inserting an
+// element again replaces its value.
+type cuckooFilter struct {
+	bits       uint
+	bucketSize uint
+	buckets    uint64
+	mask       uint64
+	fpMask     uint64
+	size       int
+	// table is padded by 7 bytes so that every slot can be read as a uint64.
+	table  []byte
+	count  uint
+	expiry []uint32
+	counts []uint16
+	// values is padded like table.
+	valueBits uint
+	values    []byte
+	// undo records how to restore the slots mutated while it is set.
+	undo *undoLog
+}
+
+// bucketCount returns the number of buckets a filter needs to hold capacity
+// elements below maxLoad.
+func bucketCount(capacity uint64, bucketSize uint) uint64 {
+	buckets := uint64(1)
+	for buckets < capacity/uint64(bucketSize) {
+		buckets <<= 1
+	}
+	if float64(capacity)/float64(buckets*uint64(bucketSize)) > maxLoad[bucketSize] {
+		buckets <<= 1
+	}
+	return buckets
+}
+
+func tableSize(buckets uint64, bits, bucketSize uint) int {
+	return int(buckets * uint64(bits*bucketSize) / 8)
+}
+
+// valuesSize returns the bytes taken by the values of slots slots, rounded up
+// to a whole byte.
+func valuesSize(slots uint64, valueBits uint) int {
+	return int((slots*uint64(valueBits) + 7) / 8)
+}
+
+// newCuckooFilter returns an empty filter of buckets buckets, which must be a
+// power of two.
+func newCuckooFilter(buckets uint64, bits, bucketSize uint) *cuckooFilter {
+	size := tableSize(buckets, bits, bucketSize)
+	return &cuckooFilter{
+		bits:       bits,
+		bucketSize: bucketSize,
+		buckets:    buckets,
+		mask:       buckets - 1,
+		fpMask:     1<<bits - 1,
+		size:       size,
+		table:      make([]byte, size+7),
+	}
+}
+
+// sibling returns an empty filter of buckets buckets laid out like f.
+func (f *cuckooFilter) sibling(buckets uint64) *cuckooFilter {
+	sibling := newCuckooFilter(buckets, f.bits, f.bucketSize)
+	if f.counts != nil {
+		sibling.enableCounting()
+	}
+	if f.values != nil {
+		sibling.enableValues(f.valueBits)
+	}
+	return sibling
+}
+
+// decodeCuckooFilter decodes the output of Encode.
+func decodeCuckooFilter(b []byte, bits, bucketSize uint) (*cuckooFilter, error) {
+	bucketBytes := int(bits * bucketSize / 8)
+	if len(b) == 0 || len(b)%bucketBytes != 0 {
+		return nil, fmt.Errorf("filter data of %d bytes is not a whole number of %d byte buckets", len(b), bucketBytes)
+	}
+	buckets := uint64(len(b) / bucketBytes)
+	if buckets&(buckets-1) != 0 {
+		return nil, fmt.Errorf("filter has %d buckets, not a power of two", buckets)
+	}
+	f := newCuckooFilter(buckets, bits, bucketSize)
+	copy(f.table, b)
+	for s := uint64(0); s < buckets*uint64(bucketSize); s++ {
+		if f.slot(s) != 0 {
+			f.count++
+		}
+	}
+	return f, nil
+}
+
+func (f *cuckooFilter) slot(s uint64) uint64 {
+	bit := s * uint64(f.bits)
+	return binary.LittleEndian.Uint64(f.table[bit/8:]) >> (bit % 8) & f.fpMask
+}
+
+func (f *cuckooFilter) setSlot(s, fp uint64) {
+	bit := s * uint64(f.bits)
+	shift := bit % 8
+	v := binary.LittleEndian.Uint64(f.table[bit/8:])
+	binary.LittleEndian.PutUint64(f.table[bit/8:], v&^(f.fpMask<<shift)|fp<<shift)
+}
+
+func (f *cuckooFilter) value(s uint64) uint16 {
+	bit := s * uint64(f.valueBits)
+	return uint16(binary.LittleEndian.Uint64(f.values[bit/8:]) >> (bit % 8) & (1<<f.valueBits - 1))
+}
+
+func (f *cuckooFilter) setValue(s uint64, value uint16) {
+	bit := s * uint64(f.valueBits)
+	shift := bit % 8
+	mask := uint64(1)<<f.valueBits - 1
+	v := binary.LittleEndian.Uint64(f.values[bit/8:])
+	binary.LittleEndian.PutUint64(f.values[bit/8:], v&^(mask<<shift)|uint64(value)<<shift)
+}
+
+// indexAndFingerprint derives the first bucket of an element from the low
+// bits of its hash and the fingerprint from the high bits, avoiding zero.
+func (f *cuckooFilter) indexAndFingerprint(hash uint64) (uint64, uint64) {
+	fp := (hash>>(64-f.bits))%(f.fpMask-1) + 1
+	return hash & f.mask, fp
+}
+
+// altIndex returns the other bucket of fp; it maps either bucket to the other.
+func (f *cuckooFilter) altIndex(fp, i uint64) uint64 {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(fp))
+	return (i ^ metro.Hash64(b[:(f.bits+7)/8], hashSeed)) & f.mask
+}
+
+// entry is the content of a slot: a fingerprint, its expiry, in a counting
+// filter how many times it was inserted and in a value filter its value.
+type entry struct {
+	fp     uint64
+	expiry uint32
+	n      uint16
+	value  uint16
+}
+
+func (f *cuckooFilter) get(s uint64) entry {
+	e := entry{fp: f.slot(s), n: 1}
+	if f.expiry != nil {
+		e.expiry = f.expiry[s]
+	}
+	if f.counts != nil {
+		e.n = f.counts[s]
+	}
+	if f.values != nil {
+		e.value = f.value(s)
+	}
+	return e
+}
+
+func (f *cuckooFilter) put(s uint64, e entry) {
+	f.save(s)
+	f.setSlot(s, e.fp)
+	if f.expiry != nil {
+		f.expiry[s] = e.expiry
+	}
+	if f.counts != nil {
+		f.counts[s] = e.n
+	}
+	if f.values != nil {
+		f.setValue(s, e.value)
+	}
+}
+
+// save records in f.undo how to restore slot s.
+func (f *cuckooFilter) save(s uint64) {
+	if f.undo != nil {
+		e := f.get(s)
+		f.undo.record(func() { f.put(s, e) })
+	}
+}
+
+// journal makes f record in u how to undo its mutations until u stops.
+func (f *cuckooFilter) journal(u *undoLog) {
+	count, expiry := f.count, f.expiry
+	u.record(func() { f.count, f.expiry = count, expiry })
+	f.undo = u
+	u.onStop(func() { f.undo = nil })
+}
+
+// live reports whether e holds a fingerprint unexpired by now.
+func (e entry) live(now uint32) bool {
+	return e.fp != 0 && (e.expiry == 0 || e.expiry > now)
+}
+
+// occurrences counts the insertions of fp into bucket i unexpired by now.
+func (f *cuckooFilter) occurrences(i, fp uint64, now uint32) uint {
+	var n uint
+	for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+		if e := f.get(s); e.fp == fp && e.live(now) {
+			n += uint(e.n)
+		}
+	}
+	return n
+}
+
+// add stores e in an empty slot of bucket i.
+func (f *cuckooFilter) add(i uint64, e entry) bool {
+	for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+		if f.slot(s) == 0 {
+			f.put(s, e)
+			return true
+		}
+	}
+	return false
+}
+
+// increment counts another insertion of e into a slot of bucket i that
+// holds the fingerprint with the same expiry.
+func (f *cuckooFilter) increment(i uint64, e entry) bool {
+	for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+		if old := f.get(s); old.fp == e.fp && old.expiry == e.expiry && old.n < math.MaxUint16 {
+			f.save(s)
+			f.counts[s]++
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the slot of bucket i that holds fp.
+func (f *cuckooFilter) find(i, fp uint64) (uint64, bool) {
+	for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+		if f.slot(s) == fp {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// replace replaces the slot of bucket i that holds the fingerprint of e.
+func (f *cuckooFilter) replace(i uint64, e entry) bool {
+	s, ok := f.find(i, e.fp)
+	if ok {
+		f.put(s, e)
+	}
+	return ok
+}
+
+// remove takes one insertion of fp unexpired by now out of bucket i. It
+// reports whether it found fp and whether that freed its slot.
+func (f *cuckooFilter) remove(i, fp uint64, now uint32) (found, freed bool) {
+	for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+		if e := f.get(s); e.fp == fp && e.live(now) {
+			if e.n > 1 {
+				f.save(s)
+				f.counts[s]--
+				return true, false
+			}
+			f.put(s, entry{})
+			return true, true
+		}
+	}
+	return false, false
+}
+
+func (f *cuckooFilter) now() uint32 {
+	if f.expiry == nil {
+		return 0
+	}
+	return expiryNow()
+}
+
+func (f *cuckooFilter) Lookup(data []byte) bool {
+	return f.Occurrences(data) > 0
+}
+
+// Occurrences returns how many times data was inserted and not deleted or
+// expired. Without counts, a fingerprint fits at most twice the bucket size
+// times into its two buckets.
+func (f *cuckooFilter) Occurrences(data []byte) uint {
+	return f.occurrencesOf(pb.HashElement(data))
+}
+
+// occurrencesOf returns how many times the element of hash was inserted and
+// not deleted or expired.
+func (f *cuckooFilter) occurrencesOf(hash uint64) uint {
+	now := f.now()
+	i1, fp := f.indexAndFingerprint(hash)
+	n := f.occurrences(i1, fp, now)
+	if i2 := f.altIndex(fp, i1); i2 != i1 {
+		n += f.occurrences(i2, fp, now)
+	}
+	return n
+}
+
+func (f *cuckooFilter) Insert(data []byte) bool {
+	return f.InsertUntil(data, 0)
+}
+
+// InsertUntil inserts data to expire at expiry, or never if it is zero.
+func (f *cuckooFilter) InsertUntil(data []byte, expiry uint32) bool {
+	return f.insert(pb.HashElement(data), entry{expiry: expiry, n: 1})
+}
+
+// InsertValue inserts data with value to expire at expiry, or never if it
+// is zero. Inserting data again replaces its value and expiry.
+func (f *cuckooFilter) InsertValue(data []byte, value uint16, expiry uint32) bool {
+	return f.insert(pb.HashElement(data), entry{expiry: expiry, n: 1, value: value})
+}
+
+// Replace replaces the value and expiry of the element of hash if f holds
+// it.
+func (f *cuckooFilter) Replace(hash uint64, value uint16, expiry uint32) bool {
+	f.expire(expiry)
+	i1, fp := f.indexAndFingerprint(hash)
+	e := entry{fp: fp, expiry: expiry, n: 1, value: value}
+	return f.replace(i1, e) || f.replace(f.altIndex(fp, i1), e)
+}
+
+// Renew replaces the expiry of the element of hash if f holds it, keeping
+// its value.
+func (f *cuckooFilter) Renew(hash uint64, expiry uint32) bool {
+	f.expire(expiry)
+	i1, fp := f.indexAndFingerprint(hash)
+	for _, i := range [2]uint64{i1, f.altIndex(fp, i1)} {
+		if s, ok := f.find(i, fp); ok {
+			e := f.get(s)
+			e.expiry = expiry
+			f.put(s, e)
+			return true
+		}
+	}
+	return false
+}
+
+// Value returns the value of data, if f holds it unexpired.
+func (f *cuckooFilter) Value(data []byte) (uint16, bool) {
+	now := f.now()
+	i1, fp := f.indexAndFingerprint(pb.HashElement(data))
+	for _, i := range [2]uint64{i1, f.altIndex(fp, i1)} {
+		for s := i * uint64(f.bucketSize); s < (i+1)*uint64(f.bucketSize); s++ {
+			if e := f.get(s); e.fp == fp && e.live(now) {
+				return e.value, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// expire makes room for the expiries of the slots once one is set.
+func (f *cuckooFilter) expire(expiry uint32) {
+	if expiry != 0 && f.expiry == nil {
+		f.expiry = make([]uint32, f.buckets*uint64(f.bucketSize))
+	}
+}
+
+// insert inserts the element of hash into a slot holding e.
+func (f *cuckooFilter) insert(hash uint64, e entry) bool {
+	f.expire(e.expiry)
+	i1, fp := f.indexAndFingerprint(hash)
+	i2 := f.altIndex(fp, i1)
+	e.fp = fp
+	if f.counts != nil && (f.increment(i1, e) || f.increment(i2, e)) {
+		return true
+	}
+	if f.values != nil && (f.replace(i1, e) || f.replace(i2, e)) {
+		return true
+	}
+	if f.add(i1, e) || f.add(i2, e) {
+		f.count++
+		return true
+	}
+
+	// Relocate entries to their other bucket until one finds room,
+	// remembering every swap so that a failed insert can be undone.
+	type swap struct {
+		slot  uint64
+		entry entry
+	}
+	var swaps [maxKickouts]swap
+	i := i1
+	if rand.Intn(2) == 0 {
+		i = i2
+	}
+	for k := 0; k < maxKickouts; k++ {
+		s := i*uint64(f.bucketSize) + uint64(rand.Intn(int(f.bucketSize)))
+		swaps[k] = swap{s, f.get(s)}
+		f.put(s, e)
+		e = swaps[k].entry
+		i = f.altIndex(e.fp, i)
+		if f.add(i, e) {
+			f.count++
+			return true
+		}
+	}
+	for k := maxKickouts - 1; k >= 0; k-- {
+		f.put(swaps[k].slot, swaps[k].entry)
+	}
+	return false
+}
+
+// Delete removes an insertion of data that has not expired. Expired ones are
+// left to Sweep, as lookups no longer find them.
+func (f *cuckooFilter) Delete(data []byte) bool {
+	return f.delete(pb.HashElement(data), f.now())
+}
+
+// delete removes an insertion of the element of hash unexpired by now, or
+// of any insertion if now is zero.
+func (f *cuckooFilter) delete(hash uint64, now uint32) bool {
+	i1, fp := f.indexAndFingerprint(hash)
+	for _, i := range [2]uint64{i1, f.altIndex(fp, i1)} {
+		if found, freed := f.remove(i, fp, now); found {
+			if freed {
+				f.count--
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Sweep removes the fingerprints that expired by now and returns how many.
+func (f *cuckooFilter) Sweep(now uint32) int {
+	swept := 0
+	for s, expiry := range f.expiry {
+		if expiry != 0 && expiry <= now {
+			f.put(uint64(s), entry{})
+			swept++
+		}
+	}
+	f.count -= uint(swept)
+	return swept
+}
+
+func (f *cuckooFilter) Count() uint {
+	return f.count
+}
+
+func (f *cuckooFilter) Reset() {
+	if f.undo != nil {
+		table := append([]byte(nil), f.table...)
+		counts := append([]uint16(nil), f.counts...)
+		values := append([]byte(nil), f.values...)
+		count, expiry := f.count, f.expiry
+		f.undo.record(func() {
+			copy(f.table, table)
+			copy(f.counts, counts)
+			copy(f.values, values)
+			f.count, f.expiry = count, expiry
+		})
+	}
+	for i := range f.table {
+		f.table[i] = 0
+	}
+	f.count = 0
+	f.expiry = nil
+	for i := range f.counts {
+		f.counts[i] = 0
+	}
+	for i := range f.values {
+		f.values[i] = 0
+	}
+}
+
+// enableCounting makes f a counting filter. f must be empty.
+func (f *cuckooFilter) enableCounting() {
+	f.counts = make([]uint16, f.buckets*uint64(f.bucketSize))
+}
+
+// enableValues makes f a filter of values of valueBits bits. f must be empty.
+func (f *cuckooFilter) enableValues(valueBits uint) {
+	f.valueBits = valueBits
+	f.values = make([]byte, valuesSize(f.buckets*uint64(f.bucketSize), valueBits)+7)
+}
+
+// valuesSize returns the bytes taken by the values of f.
+func (f *cuckooFilter) valuesSize() int {
+	if f.values == nil {
+		return 0
+	}
+	return len(f.values) - 7
+}
+
+// LoadFactor returns the fraction of occupied slots.
+func (f *cuckooFilter) LoadFactor() float64 {
+	return float64(f.count) / float64(f.buckets*uint64(f.bucketSize))
+}
+
+// Encode returns the packed fingerprints.
+func (f *cuckooFilter) Encode() []byte {
+	return append([]byte(nil), f.table[:f.size]...)
+}
+
+// appendExpiry appends the expiry of every slot, little endian.
+func (f *cuckooFilter) appendExpiry(b []byte) []byte {
+	for _, expiry := range f.expiry {
+		b = append(b, byte(expiry), byte(expiry>>8), byte(expiry>>16), byte(expiry>>24))
+	}
+	return b
+}
+
+// appendCounts appends the count of every slot, little endian.
+func (f *cuckooFilter) appendCounts(b []byte) []byte {
+	for _, n := range f.counts {
+		b = append(b, byte(n), byte(n>>8))
+	}
+	return b
+}
+
+// appendValues appends the packed values.
+func (f *cuckooFilter) appendValues(b []byte) []byte {
+	return append(b, f.values[:f.valuesSize()]...)
+}
+
+// decodeValues decodes the output of appendValues, given the bits of a value.
+func (f *cuckooFilter) decodeValues(b []byte, valueBits uint) error {
+	f.enableValues(valueBits)
+	copy(f.values, b)
+	for s := uint64(0); s < f.buckets*uint64(f.bucketSize); s++ {
+		if f.value(s) != 0 && f.slot(s) == 0 {
+			return errors.New("filter values do not match its fingerprints")
+		}
+	}
+	return nil
+}
+
+// decodeCounts decodes the output of appendCounts.
+func (f *cuckooFilter) decodeCounts(b []byte) error {
+	f.enableCounting()
+	for s := range f.counts {
+		f.counts[s] = binary.LittleEndian.Uint16(b[2*s:])
+		if (f.counts[s] == 0) != (f.slot(uint64(s)) == 0) {
+			return errors.New("filter counts do not match its fingerprints")
+		}
+	}
+	return nil
+}
+
+// decodeExpiry decodes the output of appendExpiry.
+func (f *cuckooFilter) decodeExpiry(b []byte) {
+	f.expiry = make([]uint32, len(b)/4)
+	for s := range f.expiry {
+		f.expiry[s] = binary.LittleEndian.Uint32(b[4*s:])
+	}
+}
+
+// falsePositiveRate estimates the chance that a lookup of an element that
+// was never inserted succeeds at a load factor. A lookup compares the
+// element's fingerprint with the occupied slots of its two buckets, each of
+// which matches with a chance of one in the number of fingerprints.
+func falsePositiveRate(loadFactor float64, bits, bucketSize uint) float64 {
+	compared := 2 * float64(bucketSize) * loadFactor
+	return 1 - math.Pow(1-1/float64(uint64(1)<<bits-2), compared)
+}
+
+// fingerprintBitsFor returns the smallest supported fingerprint that keeps
+// the false positive rate of a full filter at most fpr.
+func fingerprintBitsFor(fpr float64, bucketSize uint) (uint, error) {
+	for _, bits := range fingerprintSizes {
+		if falsePositiveRate(1, bits, bucketSize) <= fpr {
+			return bits, nil
+		}
+	}
+	return 0, errors.New("false positive rate is too low for 32 bit fingerprints")
+}
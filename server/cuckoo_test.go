@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/panmari/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newFilter returns an empty fixed size filter of the default layout.
+func newFilter(capacity uint) *filter {
+	return createFilter(filterConfig{Capacity: uint64(capacity)})
+}
+
+func TestCuckooFilterLayouts(t *testing.T) {
+	for _, bits := range fingerprintSizes {
+		for _, bucketSize := range bucketSizes {
+			f := newCuckooFilter(bucketCount(1000, bucketSize), bits, bucketSize)
+			for i := 0; i < 1000; i++ {
+				assert.True(t, f.Insert([]byte(strconv.Itoa(i))), "%d/%d: %d", bits, bucketSize, i)
+			}
+			assert.Equal(t, uint(1000), f.Count())
+			for i := 0; i < 1000; i++ {
+				assert.True(t, f.Lookup([]byte(strconv.Itoa(i))), "%d/%d: %d", bits, bucketSize, i)
+			}
+
+			decoded, err := decodeCuckooFilter(f.Encode(), bits, bucketSize)
+			assert.NoError(t, err)
+			assert.Equal(t, f.Count(), decoded.Count())
+
+			for i := 0; i < 1000; i += 2 {
+				assert.True(t, f.Delete([]byte(strconv.Itoa(i))), "%d/%d: %d", bits, bucketSize, i)
+			}
+			for i := 1; i < 1000; i += 2 {
+				assert.True(t, f.Lookup([]byte(strconv.Itoa(i))), "%d/%d: %d", bits, bucketSize, i)
+			}
+			assert.Equal(t, uint(500), f.Count())
+		}
+	}
+}
+
+func TestCuckooFilterFalsePositiveRate(t *testing.T) {
+	for _, bits := range []uint{8, 12} {
+		f := newCuckooFilter(bucketCount(10000, 4), bits, 4)
+		for i := 0; i < 10000; i++ {
+			f.Insert([]byte(strconv.Itoa(i)))
+		}
+		hits := 0
+		for i := 10000; i < 110000; i++ {
+			if f.Lookup([]byte(strconv.Itoa(i))) {
+				hits++
+			}
+		}
+		want := falsePositiveRate(f.LoadFactor(), bits, 4)
+		assert.InEpsilon(t, want, float64(hits)/100000, 0.25, bits)
+	}
+}
+
+func TestCuckooFilterFailedInsert(t *testing.T) {
+	f := newCuckooFilter(1, 16, 4)
+	i := 0
+	for ; f.Insert([]byte(strconv.Itoa(i))); i++ {
+	}
+	before := f.Encode()
+	assert.False(t, f.Insert([]byte(strconv.Itoa(i))))
+	assert.Equal(t, before, f.Encode())
+	for j := 0; j < i; j++ {
+		assert.True(t, f.Lookup([]byte(strconv.Itoa(j))), j)
+	}
+}
+
+// Filters of 16 bit fingerprints in buckets of 4 are interchangeable with
+// panmari/cuckoofilter ones, whose encoding old snapshots hold.
+func TestCuckooFilterPanmariCompatible(t *testing.T) {
+	for _, capacity := range []uint64{0, 1, 3, 4, 5, 100, 1000, 1023, 1024, 4000, 4096, 1000000} {
+		cf := cuckoo.NewFilter(uint(capacity))
+		assert.Equal(t, uint64(len(cf.Encode())/8), bucketCount(capacity, 4), capacity)
+	}
+
+	cf := cuckoo.NewFilter(1000)
+	for i := 0; i < 500; i++ {
+		cf.Insert([]byte(strconv.Itoa(i)))
+	}
+	f, err := decodeCuckooFilter(cf.Encode(), 16, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, cf.Count(), f.Count())
+	for i := 0; i < 1000; i++ {
+		assert.Equal(t, cf.Lookup([]byte(strconv.Itoa(i))), f.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+	for i := 500; i < 600; i++ {
+		f.Insert([]byte(strconv.Itoa(i)))
+	}
+	cf, err = cuckoo.Decode(f.Encode())
+	assert.NoError(t, err)
+	for i := 0; i < 600; i++ {
+		assert.True(t, cf.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+}
+
+func TestCreateFilterFalsePositiveRate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000, FalsePositiveRate: 0.01})
+	assert.NoError(t, err)
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint32(12), res.Info.FingerprintBits)
+	assert.Equal(t, uint32(4), res.Info.BucketSize)
+	assert.Equal(t, 0.01, res.Info.TargetFalsePositiveRate)
+	assert.Equal(t, uint64(512*4*12/8), res.Info.MemoryBytes)
+
+	_, err = s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 1000, FingerprintBits: 8, BucketSize: 2})
+	assert.NoError(t, err)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
+	assert.True(t, found(s, "bbb", "jack"))
+	res, _ = s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "bbb"})
+	assert.Equal(t, uint32(8), res.Info.FingerprintBits)
+	assert.Equal(t, uint32(2), res.Info.BucketSize)
+	assert.Equal(t, uint64(1024), res.Info.BucketCount)
+	assert.Equal(t, uint64(2048), res.Info.MemoryBytes)
+
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "ccc", Capacity: 10, FalsePositiveRate: 0.01, FingerprintBits: 16},
+		{FilterName: "ccc", Capacity: 10, FalsePositiveRate: 1},
+		{FilterName: "ccc", Capacity: 10, FalsePositiveRate: -0.1},
+		{FilterName: "ccc", Capacity: 10, FalsePositiveRate: 1e-12},
+		{FilterName: "ccc", Capacity: 10, FingerprintBits: 10},
+		{FilterName: "ccc", Capacity: 10, BucketSize: 3},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err), req.String())
+	}
+}
+
+func TestCreateFilterInvalidCapacity(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Capacities whose table would not fit are rejected rather than
+	// allocating a table that overflowed.
+	s := NewServer()
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "aaa", Capacity: 1 << 62},
+		{FilterName: "aaa", Capacity: math.MaxUint64},
+		{FilterName: "aaa", Capacity: maxTableSize, FingerprintBits: 32},
+		{FilterName: "aaa", Capacity: 1 << 62, Window: durationpb.New(time.Hour)},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err), req.String())
+		var field string
+		for _, d := range status.Convert(err).Details() {
+			if bad, ok := d.(*errdetails.BadRequest); ok {
+				field = bad.FieldViolations[0].Field
+			}
+		}
+		assert.Equal(t, "capacity", field, req.String())
+	}
+	assert.Empty(t, s.filters.names())
+}
+
+func TestFilterLayoutSurvivesRestart(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, FingerprintBits: 32, BucketSize: 8})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+
+	// The layout survives a replay of the log and a snapshot.
+	s = restart(t, s, dir)
+	assert.NoError(t, s.Dump(dir))
+	s = restart(t, s, dir)
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint32(32), res.Info.FingerprintBits)
+	assert.Equal(t, uint32(8), res.Info.BucketSize)
+	assert.Equal(t, uint64(512), res.Info.MemoryBytes)
+	assert.True(t, found(s, "aaa", "jack"))
+}
+
+func TestCuckooFilterCounting(t *testing.T) {
+	f := newCuckooFilter(bucketCount(100, 4), 16, 4)
+	f.enableCounting()
+	for i := 0; i < 1000; i++ {
+		assert.True(t, f.Insert([]byte("jack")), i)
+	}
+	f.Insert([]byte("mary"))
+	assert.Equal(t, uint(1000), f.Occurrences([]byte("jack")))
+	assert.Equal(t, uint(1), f.Occurrences([]byte("mary")))
+	assert.Equal(t, uint(2), f.Count())
+
+	// Counts follow the fingerprints they are relocated with.
+	for i := 0; f.Insert([]byte(strconv.Itoa(i))); i++ {
+	}
+	assert.Equal(t, uint(1000), f.Occurrences([]byte("jack")))
+	assert.True(t, f.Delete([]byte("jack")))
+	assert.Equal(t, uint(999), f.Occurrences([]byte("jack")))
+
+	decoded, err := decodeCuckooFilter(f.Encode(), 16, 4)
+	assert.NoError(t, err)
+	assert.NoError(t, decoded.decodeCounts(f.appendCounts(nil)))
+	assert.Equal(t, uint(999), decoded.Occurrences([]byte("jack")))
+	counts := f.appendCounts(nil)
+	counts[0] ^= 1
+	assert.Error(t, decoded.decodeCounts(counts))
+
+	// Without counts, duplicates take a slot each.
+	f = newCuckooFilter(bucketCount(100, 4), 16, 4)
+	for f.Insert([]byte("jack")) {
+	}
+	assert.Equal(t, uint(8), f.Occurrences([]byte("jack")))
+	assert.Equal(t, uint(8), f.Count())
+}
+
+func TestCountElement(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, Counting: true})
+	for i := 0; i < 20; i++ {
+		s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	}
+	s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"mary", "mary", "rose"}})
+	assert.NoError(t, s.Dump(dir))
+	s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+
+	// Counts survive a snapshot and a replay of the log.
+	s = restart(t, s, dir)
+	res, err := s.CountElement(ctx, &pb.CountElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(19), res.Count)
+	each, err := s.CountEachElement(ctx, &pb.CountEachElementRequest{FilterName: "aaa", Elements: []string{"mary", "rose", "lily"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2, 1, 0}, each.Counts)
+
+	info, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.True(t, info.Info.Counting)
+	assert.Equal(t, uint64(3), info.Info.Count)
+
+	_, err = s.CountElement(ctx, &pb.CountElementRequest{FilterName: "bbb", Element: "jack"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.CountEachElement(ctx, &pb.CountEachElementRequest{FilterName: "aaa", Elements: make([]string, maxElementCount+1)})
+	assert.Equal(t, "TOO_MANY_ELEMENTS", errorReason(err))
+}
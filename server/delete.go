@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"io"
+)
+
+func (s *cuckooFilterServer) DeleteElements(ctx context.Context, req *pb.DeleteElementsRequest) (*pb.DeleteElementsResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.DeleteElementsResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	elements := elementSet{req.Elements, req.RawElements}
+	if elements.len() > maxElementCount {
+		return &pb.DeleteElementsResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("elements"))
+	}
+	if !filter.cf.supports(canDelete) {
+		return &pb.DeleteElementsResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canDelete))
+	}
+	results := make([]pb.DeleteResult, elements.len())
+	err := s.update(filter, func() *walRecord {
+		deleted := make([][]byte, 0, len(results))
+		for i := range results {
+			if element := elements.at(i); filter.cf.Delete(element) {
+				results[i] = pb.DeleteResult_DELETED
+				deleted = append(deleted, element)
+			} else {
+				results[i] = pb.DeleteResult_NOT_FOUND
+			}
+		}
+		filter.stats.deleted(len(deleted))
+		if len(deleted) == 0 {
+			return nil
+		}
+		return &walRecord{op: opDeleteElements, name: req.FilterName, elements: deleted}
+	})
+	if err != nil {
+		return &pb.DeleteElementsResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	return &pb.DeleteElementsResponse{Status: StatusOK, Results: results}, nil
+}
+
+// DeleteElementsStream deletes each batch of the stream as DeleteElements
+// does and answers it before reading the next. A batch that fails ends the
+// stream, except in legacy mode, where its response carries the failure.
+func (s *cuckooFilterServer) DeleteElementsStream(stream pb.CuckooFilter_DeleteElementsStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		res, err := s.DeleteElements(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDeleteElements(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary", "rose", "rose"}})
+	assert.NoError(t, err)
+
+	// An element repeated in the batch is deleted as many times as the
+	// filter holds it.
+	res, err := s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "aaa", Elements: []string{"jack", "tom", "rose"}, RawElements: [][]byte{[]byte("rose"), []byte("rose")}})
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.DeleteResult{pb.DeleteResult_DELETED, pb.DeleteResult_NOT_FOUND, pb.DeleteResult_DELETED, pb.DeleteResult_DELETED, pb.DeleteResult_NOT_FOUND}, res.Results)
+	info, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(3), info.Info.Stats.Deletes)
+
+	s = restart(t, s, dir)
+	for _, e := range []string{"jack", "rose"} {
+		assert.False(t, found(s, "aaa", e), e)
+	}
+	assert.True(t, found(s, "aaa", "mary"))
+}
+
+func TestDeleteElementsFailures(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	_, err = s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "aaa", Elements: make([]string, maxElementCount+1)})
+	assert.Equal(t, "TOO_MANY_ELEMENTS", errorReason(err))
+	_, err = s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "bbb", Elements: []string{"jack"}})
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+	_, err = s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 100, Backend: "bloom"})
+	assert.NoError(t, err)
+	_, err = s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "bbb", Elements: []string{"jack"}})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+}
+
+func TestDeleteElementsStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	c := newTestClient(t, s)
+	_, err := c.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 20000})
+	assert.NoError(t, err)
+	batches := make([][]string, 3)
+	for i := range batches {
+		batches[i] = make([]string, maxElementCount)
+		for j := range batches[i] {
+			batches[i][j] = strconv.Itoa(i*maxElementCount + j)
+		}
+		_, err := c.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: batches[i]})
+		assert.NoError(t, err)
+	}
+
+	stream, err := c.DeleteElementsStream(ctx)
+	assert.NoError(t, err)
+	for _, batch := range batches {
+		assert.NoError(t, stream.Send(&pb.DeleteElementsRequest{FilterName: "aaa", Elements: batch}))
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, maxElementCount)
+	}
+	assert.NoError(t, stream.Send(&pb.DeleteElementsRequest{FilterName: "aaa", Elements: []string{"tom"}}))
+	res, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.DeleteResult{pb.DeleteResult_NOT_FOUND}, res.Results)
+	assert.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	count, _ := c.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Zero(t, count.Len)
+}
+
+func TestDeleteElementsStreamFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// A failed batch ends the stream.
+	c := newTestClient(t, NewServer())
+	stream, err := c.DeleteElementsStream(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.DeleteElementsRequest{FilterName: "bbb", Elements: []string{"jack"}}))
+	_, err = stream.Recv()
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// elementOf returns the element of a request that sets it either as a string
+// or as raw bytes, which hash alike.
+func elementOf(element string, raw []byte) ([]byte, *errdetails.BadRequest) {
+	if len(raw) == 0 {
+		return []byte(element), nil
+	}
+	if element != "" {
+		return nil, badRequest("raw_element", "element and raw_element cannot both be set")
+	}
+	return raw, nil
+}
+
+// elementSet holds the elements of a request, sent as strings, raw bytes or
+// both. The raw elements follow the string ones.
+type elementSet struct {
+	strings []string
+	raw     [][]byte
+}
+
+func (s elementSet) len() int {
+	return len(s.strings) + len(s.raw)
+}
+
+func (s elementSet) at(i int) []byte {
+	if i < len(s.strings) {
+		return []byte(s.strings[i])
+	}
+	return s.raw[i-len(s.strings)]
+}
+
+// pick returns the elements at indices in the form the request sent them.
+func (s elementSet) pick(indices []int) ([]string, [][]byte) {
+	var strings []string
+	var raw [][]byte
+	for _, i := range indices {
+		if i < len(s.strings) {
+			strings = append(strings, s.strings[i])
+		} else {
+			raw = append(raw, s.raw[i-len(s.strings)])
+		}
+	}
+	return strings, raw
+}
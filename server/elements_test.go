@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRawElements(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("jack"))
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", RawElement: digest[:]})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"mary"}, RawElements: [][]byte{[]byte("rose"), {0xff, 0x00}}})
+	assert.NoError(t, err)
+
+	// Both forms of an element are the same element.
+	s = restart(t, s, dir)
+	res, err := s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"rose", "tom"}, RawElements: [][]byte{digest[:], []byte("jack"), []byte("mary"), {0xff}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rose"}, res.MatchedElements)
+	assert.Equal(t, []string{"tom"}, res.UnmatchedElements)
+	assert.Equal(t, [][]byte{digest[:], []byte("jack"), []byte("mary")}, res.RawMatchedElements)
+	assert.Equal(t, [][]byte{{0xff}}, res.RawUnmatchedElements)
+	counts, err := s.CountEachElement(ctx, &pb.CountEachElementRequest{FilterName: "aaa", Elements: []string{"jack"}, RawElements: [][]byte{[]byte("jack"), {0xff, 0x00}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 1, 1}, counts.Counts)
+	count, err := s.CountElement(ctx, &pb.CountElementRequest{FilterName: "aaa", RawElement: []byte("mary")})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), count.Count)
+}
+
+func TestRawElementsDelete(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "rose"}})
+	assert.NoError(t, err)
+
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", RawElement: []byte("jack")})
+	assert.NoError(t, err)
+	lookup, err := s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.False(t, lookup.Found)
+	unique, err := s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", RawElement: []byte("rose")})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.InsertResult_ALREADY_PRESENT, unique.Result)
+}
+
+func TestRawAndStringElement(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "rose"})
+	assert.NoError(t, err)
+
+	// An element is sent in one form only.
+	for _, err := range []error{
+		func() error {
+			_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "tom", RawElement: []byte("tom")})
+			return err
+		}(),
+		func() error {
+			_, err := s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "rose", RawElement: []byte("rose")})
+			return err
+		}(),
+		func() error {
+			_, err := s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "rose", RawElement: []byte("rose")})
+			return err
+		}(),
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "INVALID_ELEMENT", errorReason(err))
+	}
+	assert.True(t, found(s, "aaa", "rose"))
+	assert.False(t, found(s, "aaa", "tom"))
+}
+
+func TestRawElementsFailed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Elements that do not fit come back in the form they were sent.
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 4})
+	assert.NoError(t, err)
+	raw := make([][]byte, 100)
+	for i := range raw {
+		raw[i] = []byte(strconv.Itoa(i))
+	}
+	failed, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "bbb", Elements: []string{"jack"}, RawElements: raw})
+	assert.Equal(t, "INSERTION_FAILED", errorReason(err))
+	assert.Empty(t, failed.FailedElements)
+	assert.NotEmpty(t, failed.RawFailedElements)
+	var detail *pb.FailedElements
+	for _, d := range status.Convert(err).Details() {
+		if f, ok := d.(*pb.FailedElements); ok {
+			detail = f
+		}
+	}
+	if assert.NotNil(t, detail) {
+		assert.Equal(t, failed.RawFailedElements, detail.RawElements)
+	}
+}
+
+// elementVector is a key with its hash and requests carrying it, computed
+// and encoded without the Go libraries, as a client in any language would.
+// Keys that are not valid UTF-8 have no string form.
+type elementVector struct {
+	Name         string `json:"name"`
+	RawElement   string `json:"raw_element"`
+	HashV1       string `json:"hash_v1"`
+	InsertRaw    string `json:"insert_raw"`
+	LookupRaw    string `json:"lookup_raw"`
+	InsertString string `json:"insert_string"`
+	LookupString string `json:"lookup_string"`
+}
+
+func TestRawElementVectors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "elements", "vectors.json"))
+	assert.NoError(t, err)
+	var vectors []elementVector
+	assert.NoError(t, json.Unmarshal(b, &vectors))
+	assert.NotEmpty(t, vectors)
+
+	decode := func(name, h string, m proto.Message) {
+		b, err := hex.DecodeString(h)
+		assert.NoError(t, err, name)
+		assert.NoError(t, proto.Unmarshal(b, m), name)
+	}
+	s := NewServer()
+	for _, v := range vectors {
+		_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+		assert.NoError(t, err, v.Name)
+		key, err := hex.DecodeString(v.RawElement)
+		assert.NoError(t, err, v.Name)
+
+		var insert pb.InsertElementRequest
+		decode(v.Name, v.InsertRaw, &insert)
+		assert.Equal(t, "aaa", insert.FilterName, v.Name)
+		assert.Equal(t, string(key), string(insert.RawElement), v.Name)
+		_, err = s.InsertElement(ctx, &insert)
+		assert.NoError(t, err, v.Name)
+		var lookup pb.LookupElementRequest
+		decode(v.Name, v.LookupRaw, &lookup)
+		res, err := s.LookupElement(ctx, &lookup)
+		assert.NoError(t, err, v.Name)
+		assert.True(t, res.Found, v.Name)
+
+		// The hash of the key finds it too.
+		hash, err := strconv.ParseUint(v.HashV1, 16, 64)
+		assert.NoError(t, err, v.Name)
+		assert.Equal(t, hash, pb.HashElement(key), v.Name)
+		hashes, err := s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "aaa", Hashes: []uint64{hash}, HashVersion: 1})
+		assert.NoError(t, err, v.Name)
+		assert.Equal(t, []uint64{hash}, hashes.MatchedHashes, v.Name)
+
+		if v.InsertString != "" {
+			// The string form finds and adds to the element the raw form
+			// inserted.
+			decode(v.Name, v.LookupString, &lookup)
+			assert.Equal(t, string(key), lookup.Element, v.Name)
+			res, err := s.LookupElement(ctx, &lookup)
+			assert.NoError(t, err, v.Name)
+			assert.True(t, res.Found, v.Name)
+			decode(v.Name, v.InsertString, &insert)
+			_, err = s.InsertElement(ctx, &insert)
+			assert.NoError(t, err, v.Name)
+			count, _ := s.CountElement(ctx, &pb.CountElementRequest{FilterName: "aaa", RawElement: key})
+			assert.Equal(t, uint64(2), count.Count, v.Name)
+		}
+		_, err = s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "aaa"})
+		assert.NoError(t, err, v.Name)
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// errorDomain is the ErrorInfo domain attached to every error the server returns.
+const errorDomain = "cuckoofilter"
+
+// statusCodes maps the legacy pb.Status codes onto gRPC codes.
+var statusCodes = map[int32]codes.Code{
+	StatusNoFilterFound.Code:       codes.NotFound,
+	StatusInsertionFailed.Code:     codes.ResourceExhausted,
+	StatusNoElementFound.Code:      codes.NotFound,
+	StatusOverLimitation.Code:      codes.InvalidArgument,
+	StatusFilterAlreadyExist.Code:  codes.AlreadyExists,
+	StatusInvalidFilterName.Code:   codes.InvalidArgument,
+	StatusInvalidFilterData.Code:   codes.InvalidArgument,
+	StatusInvalidFilterConfig.Code: codes.InvalidArgument,
+	StatusInvalidTTL.Code:          codes.InvalidArgument,
+	StatusUnsupported.Code:         codes.FailedPrecondition,
+	StatusInvalidValue.Code:        codes.InvalidArgument,
+	StatusInvalidElement.Code:      codes.InvalidArgument,
+	StatusInvalidHashVersion.Code:  codes.InvalidArgument,
+	StatusLogFailed.Code:           codes.Internal,
+}
+
+// statusReasons gives each legacy pb.Status a stable ErrorInfo reason, so that
+// clients can tell apart failures that share a gRPC code.
+var statusReasons = map[int32]string{
+	StatusNoFilterFound.Code:       "FILTER_NOT_FOUND",
+	StatusInsertionFailed.Code:     "INSERTION_FAILED",
+	StatusNoElementFound.Code:      "ELEMENT_NOT_FOUND",
+	StatusOverLimitation.Code:      "TOO_MANY_ELEMENTS",
+	StatusFilterAlreadyExist.Code:  "FILTER_ALREADY_EXISTS",
+	StatusInvalidFilterName.Code:   "INVALID_FILTER_NAME",
+	StatusInvalidFilterData.Code:   "INVALID_FILTER_DATA",
+	StatusInvalidFilterConfig.Code: "INVALID_FILTER_CONFIG",
+	StatusInvalidTTL.Code:          "INVALID_TTL",
+	StatusUnsupported.Code:         "UNSUPPORTED_OPERATION",
+	StatusInvalidValue.Code:        "INVALID_VALUE",
+	StatusInvalidElement.Code:      "INVALID_ELEMENT",
+	StatusInvalidHashVersion.Code:  "INVALID_HASH_VERSION",
+	StatusLogFailed.Code:           "LOG_FAILED",
+}
+
+// Option configures a server created by NewServer.
+type Option func(*cuckooFilterServer)
+
+// WithLegacyStatus makes failed RPCs succeed at the gRPC level and report the
+// failure only in the response's Status field, as servers did before they
+// returned gRPC errors. It is meant for clients that have not migrated yet.
+func WithLegacyStatus() Option {
+	return func(s *cuckooFilterServer) {
+		s.legacyStatus = true
+	}
+}
+
+// fail returns the gRPC error for a failed RPC whose response carries st.
+// RPCs hand back the response alongside the error; gRPC drops it whenever the
+// error is non-nil, and in legacy mode fail returns nil so st is the only report.
+func (s *cuckooFilterServer) fail(st *pb.Status, filterName string, details ...proto.Message) error {
+	if s.legacyStatus {
+		return nil
+	}
+	return statusError(st, filterName, details...)
+}
+
+func statusError(st *pb.Status, filterName string, details ...proto.Message) error {
+	info := &errdetails.ErrorInfo{
+		Reason: statusReasons[st.Code],
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"filter_name": filterName,
+			"legacy_code": strconv.Itoa(int(st.Code)),
+		},
+	}
+	code := statusCodes[st.Code]
+	if st == StatusNoFilterFound || st == StatusFilterAlreadyExist {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: "filter",
+			ResourceName: filterName,
+			Description:  st.Msg,
+		})
+	}
+	sts, err := status.New(code, st.Msg).WithDetails(append([]proto.Message{info}, details...)...)
+	if err != nil {
+		return status.Error(code, st.Msg)
+	}
+	return sts.Err()
+}
+
+// unsupported describes the capability the backend of a filter lacks.
+func unsupported(f *filter, c capability) *errdetails.PreconditionFailure {
+	return &errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{
+		Type:        "BACKEND",
+		Subject:     f.config.backend(),
+		Description: fmt.Sprintf("this %s filter does not support %s", f.config.backend(), capabilityNames[c]),
+	}}}
+}
+
+// logFailure describes why the write-ahead log failed to record a mutation.
+func logFailure(err error) *errdetails.DebugInfo {
+	return &errdetails.DebugInfo{Detail: err.Error()}
+}
+
+func overLimitation(field string) *errdetails.BadRequest {
+	return badRequest(field, StatusOverLimitation.Msg)
+}
+
+func invalidArgument(field string, err error) *errdetails.BadRequest {
+	return badRequest(field, err.Error())
+}
+
+// configError returns the error of decoding a snapshot whose config has the
+// violation bad.
+func configError(bad *errdetails.BadRequest) error {
+	v := bad.FieldViolations[0]
+	return fmt.Errorf("snapshot config %s: %s", v.Field, v.Description)
+}
+
+func badRequest(field, description string) *errdetails.BadRequest {
+	return &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: description},
+	}}
+}
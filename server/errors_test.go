@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func errorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestErrorCodes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.filters.set("aaa", newFilter(100))
+
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Equal(t, "FILTER_ALREADY_EXISTS", errorReason(err))
+
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "ELEMENT_NOT_FOUND", errorReason(err))
+
+	_, err = s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: make([]string, maxElementCount+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "TOO_MANY_ELEMENTS", errorReason(err))
+}
+
+func TestErrorCodesLookupMiss(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.filters.set("aaa", newFilter(100))
+
+	res, err := s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.False(t, res.Found)
+
+	var resm *pb.LookupElementsResponse
+	resm, err = s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"jack"}})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusOK, resm.Status)
+	assert.Equal(t, []string{"jack"}, resm.UnmatchedElements)
+}
+
+func TestErrorCodesFailedElements(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.filters.set("aaa", newFilter(1))
+
+	elements := make([]string, 100)
+	for i := range elements {
+		elements[i] = string(rune('a' + i))
+	}
+	_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: elements})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	var failed *pb.FailedElements
+	for _, d := range status.Convert(err).Details() {
+		if f, ok := d.(*pb.FailedElements); ok {
+			failed = f
+		}
+	}
+	if assert.NotNil(t, failed) {
+		assert.NotEmpty(t, failed.Elements)
+	}
+}
+
+func TestLegacyStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer(WithLegacyStatus())
+	s.filters.set("aaa", newFilter(100))
+
+	res, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusNoFilterFound, res.Status)
+
+	var resm *pb.LookupElementsResponse
+	resm, err = s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"jack"}})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusNoElementFound, resm.Status)
+}
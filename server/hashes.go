@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// checkHashVersion rejects hashes of any version but the one the filters
+// compute.
+func checkHashVersion(version uint32) *errdetails.BadRequest {
+	if version != pb.HashVersion {
+		return badRequest("hash_version", fmt.Sprintf("hash version must be %d", pb.HashVersion))
+	}
+	return nil
+}
+
+func (s *cuckooFilterServer) InsertHashes(ctx context.Context, req *pb.InsertHashesRequest) (*pb.InsertHashesResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.InsertHashesResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if len(req.Hashes) > maxElementCount {
+		return &pb.InsertHashesResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("hashes"))
+	}
+	if bad := checkHashVersion(req.HashVersion); bad != nil {
+		return &pb.InsertHashesResponse{Status: StatusInvalidHashVersion}, s.fail(StatusInvalidHashVersion, req.FilterName, bad)
+	}
+	if !filter.cf.supports(canInsert) {
+		return &pb.InsertHashesResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canInsert))
+	}
+	if !filter.cf.supports(canHash) {
+		return &pb.InsertHashesResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canHash))
+	}
+	expiry, bad := expiryOf(req.Ttl)
+	if bad != nil {
+		return &pb.InsertHashesResponse{Status: StatusInvalidTTL}, s.fail(StatusInvalidTTL, req.FilterName, bad)
+	}
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return &pb.InsertHashesResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canExpire))
+	}
+	var failedHashes []uint64
+	err := s.update(filter, func() *walRecord {
+		inserted := make([]uint64, 0, len(req.Hashes))
+		for _, h := range req.Hashes {
+			if filter.cf.InsertHash(h, expiry) {
+				inserted = append(inserted, h)
+			} else {
+				failedHashes = append(failedHashes, h)
+			}
+		}
+		filter.stats.inserted(len(inserted), len(failedHashes))
+		if len(inserted) == 0 {
+			return nil
+		}
+		return &walRecord{op: opInsertHashes, name: req.FilterName, hashes: inserted, expiry: expiry}
+	})
+	if err != nil {
+		return &pb.InsertHashesResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	if len(failedHashes) > 0 {
+		err := s.fail(StatusInsertionFailed, req.FilterName, &pb.FailedElements{Hashes: failedHashes})
+		return &pb.InsertHashesResponse{Status: StatusInsertionFailed, FailedHashes: failedHashes}, err
+	}
+	return &pb.InsertHashesResponse{Status: StatusOK}, nil
+}
+
+func (s *cuckooFilterServer) LookupHashes(ctx context.Context, req *pb.LookupHashesRequest) (*pb.LookupHashesResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.LookupHashesResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if len(req.Hashes) > maxElementCount {
+		return &pb.LookupHashesResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("hashes"))
+	}
+	if bad := checkHashVersion(req.HashVersion); bad != nil {
+		return &pb.LookupHashesResponse{Status: StatusInvalidHashVersion}, s.fail(StatusInvalidHashVersion, req.FilterName, bad)
+	}
+	if !filter.cf.supports(canHash) {
+		return &pb.LookupHashesResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canHash))
+	}
+	var matchedHashes, unmatchedHashes []uint64
+	filter.mu.RLock()
+	for _, h := range req.Hashes {
+		if filter.cf.LookupHash(h) {
+			matchedHashes = append(matchedHashes, h)
+		} else {
+			unmatchedHashes = append(unmatchedHashes, h)
+		}
+	}
+	filter.mu.RUnlock()
+	filter.stats.lookedUp(len(req.Hashes), len(matchedHashes))
+	if len(matchedHashes) == 0 && s.legacyStatus {
+		return &pb.LookupHashesResponse{Status: StatusNoElementFound}, nil
+	}
+	return &pb.LookupHashesResponse{Status: StatusOK, MatchedHashes: matchedHashes, UnmatchedHashes: unmatchedHashes}, nil
+}
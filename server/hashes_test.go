@@ -0,0 +1,163 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"hash/crc32"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestInsertHashes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "aaa", Capacity: 1000},
+		{FilterName: "bbb", Capacity: 100, Window: durationpb.New(time.Hour)},
+		{FilterName: "ccc", Capacity: 100, ValueBits: 8},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.NoError(t, err)
+	}
+	for _, name := range []string{"aaa", "bbb", "ccc"} {
+		_, err := s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: name, Hashes: pb.HashStrings("jack", "mary"), HashVersion: pb.HashVersion})
+		assert.NoError(t, err, name)
+	}
+	assert.NoError(t, s.Dump(dir))
+	for _, name := range []string{"aaa", "bbb", "ccc"} {
+		_, err := s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: name, Hashes: pb.HashStrings("rose"), HashVersion: pb.HashVersion, Ttl: durationpb.New(time.Hour)})
+		assert.NoError(t, err, name)
+	}
+	_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "lucy"})
+	assert.NoError(t, err)
+
+	// A hash stands for its element, through a snapshot and a replay of
+	// the log.
+	s = restart(t, s, dir)
+	for _, name := range []string{"aaa", "bbb", "ccc"} {
+		for _, e := range []string{"jack", "mary", "rose"} {
+			assert.True(t, found(s, name, e), name+" "+e)
+		}
+	}
+	res, err := s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "aaa", Hashes: pb.HashStrings("lucy", "tom", "jack"), HashVersion: pb.HashVersion})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.HashStrings("lucy", "jack"), res.MatchedHashes)
+	assert.Equal(t, pb.HashStrings("tom"), res.UnmatchedHashes)
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(4), count.Len)
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.False(t, found(s, "aaa", "jack"))
+	value, _ := s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "ccc", Element: "mary"})
+	assert.True(t, value.Found)
+	assert.Zero(t, value.Value)
+}
+
+func TestInsertHashesFull(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Hashes that do not fit fail the request and are listed.
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "ddd", Capacity: 4})
+	assert.NoError(t, err)
+	hashes := make([]uint64, 100)
+	for i := range hashes {
+		hashes[i] = pb.HashString(strconv.Itoa(i))
+	}
+	failed, err := s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: "ddd", Hashes: hashes, HashVersion: pb.HashVersion})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NotEmpty(t, failed.FailedHashes)
+}
+
+func TestHashesInvalid(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, err)
+	hashes := pb.HashStrings("jack", "mary")
+	_, err = s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: "aaa", Hashes: hashes})
+	assert.Equal(t, "INVALID_HASH_VERSION", errorReason(err))
+	_, err = s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "aaa", Hashes: hashes, HashVersion: 2})
+	assert.Equal(t, "INVALID_HASH_VERSION", errorReason(err))
+	_, err = s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "aaa", Hashes: make([]uint64, maxElementCount+1), HashVersion: pb.HashVersion})
+	assert.Equal(t, "TOO_MANY_ELEMENTS", errorReason(err))
+}
+
+func TestHashesUnsupported(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, Backend: "bloom"})
+	assert.NoError(t, err)
+	_, err = s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: "aaa", Hashes: pb.HashStrings("jack"), HashVersion: pb.HashVersion})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "aaa", Hashes: pb.HashStrings("jack"), HashVersion: pb.HashVersion})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+}
+
+func TestHashesStatic(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Static filters are looked up by hash but cannot take inserts.
+	s := NewServer()
+	_, err := buildStaticFilter(newTestClient(t, s), &pb.BuildStaticFilterRequest{FilterName: "bbb"}, []string{"jack", "mary"})
+	assert.NoError(t, err)
+	res, err := s.LookupHashes(ctx, &pb.LookupHashesRequest{FilterName: "bbb", Hashes: pb.HashStrings("jack", "tom"), HashVersion: pb.HashVersion})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.HashStrings("jack"), res.MatchedHashes)
+	_, err = s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: "bbb", Hashes: pb.HashStrings("tom"), HashVersion: pb.HashVersion})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+}
+
+func TestInsertHashesRecord(t *testing.T) {
+	rec := &walRecord{op: opInsertHashes, name: "aaa", hashes: []uint64{1, math.MaxUint64}, slice: 7, expiry: 9}
+	b := rec.marshal(nil)
+	got, err := readRecord(bytes.NewReader(b))
+	assert.NoError(t, err)
+	assert.Equal(t, rec, got)
+
+	// A record claiming more hashes than it holds is corrupt.
+	rec.hashes = nil
+	b = rec.marshal(nil)
+	b[8+1+4] = 2
+	binary.LittleEndian.PutUint32(b[4:], crc32.Checksum(b[8:], crcTable))
+	_, err = readRecord(bytes.NewReader(b))
+	assert.Equal(t, errCorruptRecord, err)
+}
+
+func TestInsertHashesKeepsValues(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, ValueBits: 8})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack", Value: 42})
+	assert.NoError(t, err)
+	_, err = s.InsertHashes(ctx, &pb.InsertHashesRequest{FilterName: "aaa", Hashes: pb.HashStrings("jack", "mary"), HashVersion: pb.HashVersion})
+	assert.NoError(t, err)
+
+	for e, want := range map[string]uint32{"jack": 42, "mary": 0} {
+		res, err := s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "aaa", Element: e})
+		assert.NoError(t, err)
+		assert.True(t, res.Found, e)
+		assert.Equal(t, want, res.Value, e)
+	}
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(2), count.Len)
+}
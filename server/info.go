@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// filterStats counts the operations on a filter. Lookups update it under
+// the filter's read lock, so every counter is updated atomically.
+type filterStats struct {
+	Inserts       uint64 `json:"inserts"`
+	FailedInserts uint64 `json:"failed_inserts"`
+	Deletes       uint64 `json:"deletes"`
+	Lookups       uint64 `json:"lookups"`
+	Hits          uint64 `json:"hits"`
+	Expired       uint64 `json:"expired"`
+}
+
+func (st *filterStats) inserted(ok, failed int) {
+	atomic.AddUint64(&st.Inserts, uint64(ok))
+	atomic.AddUint64(&st.FailedInserts, uint64(failed))
+}
+
+func (st *filterStats) deleted(n int) {
+	atomic.AddUint64(&st.Deletes, uint64(n))
+}
+
+func (st *filterStats) lookedUp(lookups, hits int) {
+	atomic.AddUint64(&st.Lookups, uint64(lookups))
+	atomic.AddUint64(&st.Hits, uint64(hits))
+}
+
+func (st *filterStats) expired(n int) {
+	atomic.AddUint64(&st.Expired, uint64(n))
+}
+
+// load returns a copy of the counters.
+func (st *filterStats) load() filterStats {
+	return filterStats{
+		Inserts:       atomic.LoadUint64(&st.Inserts),
+		FailedInserts: atomic.LoadUint64(&st.FailedInserts),
+		Deletes:       atomic.LoadUint64(&st.Deletes),
+		Lookups:       atomic.LoadUint64(&st.Lookups),
+		Hits:          atomic.LoadUint64(&st.Hits),
+		Expired:       atomic.LoadUint64(&st.Expired),
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func (f *filter) info(name string) *pb.FilterInfo {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	var window *durationpb.Duration
+	if f.config.Window != 0 {
+		window = durationpb.New(f.config.Window)
+	}
+	stats := f.stats.load()
+	info := &pb.FilterInfo{
+		Name:                    name,
+		Backend:                 f.config.backend(),
+		Capacity:                f.config.Capacity,
+		Count:                   uint64(f.cf.Count()),
+		TargetFalsePositiveRate: f.config.FalsePositiveRate,
+		Window:                  window,
+		WindowSlices:            uint32(f.config.Slices),
+		Counting:                f.config.Counting,
+		ValueBits:               uint32(f.config.ValueBits),
+		Created:                 timestamp(f.created),
+		LastModified:            timestamp(f.modified),
+		Stats: &pb.FilterStats{
+			Inserts:       stats.Inserts,
+			FailedInserts: stats.FailedInserts,
+			Deletes:       stats.Deletes,
+			Lookups:       stats.Lookups,
+			Hits:          stats.Hits,
+			Expired:       stats.Expired,
+		},
+	}
+	f.cf.info(info)
+	return info
+}
+
+func (s *cuckooFilterServer) GetFilterInfo(ctx context.Context, req *pb.GetFilterInfoRequest) (*pb.GetFilterInfoResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.GetFilterInfoResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	return &pb.GetFilterInfoResponse{Status: StatusOK, Info: filter.info(req.FilterName)}, nil
+}
+
+// DescribeFilters describes the requested filters, or every filter sorted by
+// name when none are requested. Requested filters that do not exist are
+// listed as missing rather than failing the whole call.
+func (s *cuckooFilterServer) DescribeFilters(ctx context.Context, req *pb.DescribeFiltersRequest) (*pb.DescribeFiltersResponse, error) {
+	if len(req.FilterNames) > maxElementCount {
+		return &pb.DescribeFiltersResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, "", overLimitation("filter_names"))
+	}
+	filters := s.filters.all()
+	names := req.FilterNames
+	if len(names) == 0 {
+		for name := range filters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	res := &pb.DescribeFiltersResponse{Status: StatusOK, Filters: make([]*pb.FilterInfo, 0, len(names))}
+	for _, name := range names {
+		if filter, ok := filters[name]; ok {
+			res.Filters = append(res.Filters, filter.info(name))
+		} else {
+			res.MissingFilters = append(res.MissingFilters, name)
+		}
+	}
+	return res, nil
+}
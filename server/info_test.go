@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestGetFilterInfo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	before := time.Now()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary", "rose"}})
+	s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "rose"})
+	s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "rose"})
+	s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
+	s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"mary", "rose"}})
+
+	res, err := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.NoError(t, err)
+	info := res.Info
+	assert.Equal(t, "aaa", info.Name)
+	assert.Equal(t, uint64(1000), info.Capacity)
+	assert.Equal(t, uint64(512), info.BucketCount)
+	assert.Equal(t, uint64(4096), info.MemoryBytes)
+	assert.Equal(t, uint64(2), info.Count)
+	assert.InDelta(t, 2.0/2048, info.LoadFactor, 1e-9)
+	assert.InDelta(t, 2*4*2.0/2048/65535, info.EstimatedFalsePositiveRate, 1e-9)
+	assert.False(t, info.Created.AsTime().Before(before.Truncate(time.Second)))
+	assert.False(t, info.LastModified.AsTime().Before(info.Created.AsTime()))
+	assert.Equal(t, &pb.FilterStats{Inserts: 3, Deletes: 1, Lookups: 3, Hits: 2}, info.Stats)
+
+	_, err = s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "bbb"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetFilterInfoFailedInserts(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 4})
+	for i := 0; i < 100; i++ {
+		s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: string(rune('a' + i))})
+	}
+	res, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(100), res.Info.Stats.Inserts+res.Info.Stats.FailedInserts)
+	assert.NotZero(t, res.Info.Stats.FailedInserts)
+	assert.Equal(t, res.Info.Stats.Inserts, res.Info.Count)
+	assert.InDelta(t, 1, res.Info.LoadFactor, 0.5)
+}
+
+func TestDescribeFilters(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 100})
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+
+	res, err := s.DescribeFilters(ctx, &pb.DescribeFiltersRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Filters, 2) {
+		assert.Equal(t, "aaa", res.Filters[0].Name)
+		assert.Equal(t, "bbb", res.Filters[1].Name)
+	}
+
+	res, err = s.DescribeFilters(ctx, &pb.DescribeFiltersRequest{FilterNames: []string{"bbb", "ccc"}})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Filters, 1) {
+		assert.Equal(t, "bbb", res.Filters[0].Name)
+	}
+	assert.Equal(t, []string{"ccc"}, res.MissingFilters)
+}
+
+func TestFilterInfoSurvivesSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
+	want, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.NoError(t, s.Dump(dir))
+
+	s = NewServer()
+	_, err := s.Load(dir)
+	assert.NoError(t, err)
+	got, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, want.Info.String(), got.Info.String())
+}
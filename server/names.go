@@ -0,0 +1,84 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxFilterNameLength keeps the file name of a filter, which escapes every
+// byte to at most three characters, within the 255 bytes most file systems
+// allow.
+const maxFilterNameLength = 80
+
+// validateFilterName enforces the filter naming rules: a name is 1 to
+// maxFilterNameLength bytes of valid UTF-8 without control characters.
+// Anything else, including '/', '.' and '..', is allowed since filter files
+// are named by encodeFileName.
+func validateFilterName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("filter name must not be empty")
+	case len(name) > maxFilterNameLength:
+		return fmt.Errorf("filter name must be at most %d bytes", maxFilterNameLength)
+	case !utf8.ValidString(name):
+		return errors.New("filter name must be valid UTF-8")
+	case strings.IndexFunc(name, unicode.IsControl) >= 0:
+		return errors.New("filter name must not contain control characters")
+	}
+	return nil
+}
+
+const upperhex = "0123456789ABCDEF"
+
+// encodeFileName turns a filter name into a file name that is safe on any
+// file system. Lowercase letters, digits, '-' and '_' are kept and every
+// other byte is written as %XX, so the result never contains a path
+// separator and is never "." or "..". Uppercase letters are escaped too, so
+// that names differing in case do not share a file on file systems that
+// ignore case.
+func encodeFileName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(upperhex[c>>4])
+		b.WriteByte(upperhex[c&15])
+	}
+	return b.String()
+}
+
+// decodeFileName reverses encodeFileName. It rejects file names that
+// encodeFileName would not produce, so a name maps to exactly one file.
+func decodeFileName(file string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(file); i++ {
+		c := file[i]
+		if c != '%' {
+			if encodeFileName(string(c)) != string(c) {
+				return "", fmt.Errorf("invalid file name %q", file)
+			}
+			b.WriteByte(c)
+			continue
+		}
+		if i+2 >= len(file) {
+			return "", fmt.Errorf("invalid file name %q", file)
+		}
+		hi, lo := strings.IndexByte(upperhex, file[i+1]), strings.IndexByte(upperhex, file[i+2])
+		if hi < 0 || lo < 0 {
+			return "", fmt.Errorf("invalid file name %q", file)
+		}
+		b.WriteByte(byte(hi<<4 | lo))
+		i += 2
+	}
+	if name := b.String(); encodeFileName(name) == file {
+		return name, nil
+	}
+	return "", fmt.Errorf("invalid file name %q", file)
+}
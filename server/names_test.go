@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateFilterName(t *testing.T) {
+	for _, name := range []string{"aaa", "a/b", "../../etc/x", ".", "..", "名字", "a b", strings.Repeat("a", maxFilterNameLength)} {
+		assert.NoError(t, validateFilterName(name), name)
+	}
+	for _, name := range []string{"", "a\x00b", "a\nb", "\xff", strings.Repeat("a", maxFilterNameLength+1)} {
+		assert.Error(t, validateFilterName(name), name)
+	}
+}
+
+func TestCreateFilterInvalidName(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	res, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "a\x00b", Capacity: 100})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "INVALID_FILTER_NAME", errorReason(err))
+	assert.Equal(t, StatusInvalidFilterName, res.Status)
+	assert.Empty(t, s.filters.names())
+}
+
+func TestFileNameEncoding(t *testing.T) {
+	for _, name := range []string{"aaa", "a/b", "../../etc/x", ".", "..", "名字", "a b", "100%", "A-Z_0-9"} {
+		file := encodeFileName(name)
+		assert.NotContains(t, file, "/")
+		assert.NotContains(t, file, ".")
+		decoded, err := decodeFileName(file)
+		assert.NoError(t, err)
+		assert.Equal(t, name, decoded)
+	}
+	assert.Equal(t, "a%2Fb", encodeFileName("a/b"))
+	assert.Equal(t, "%46oo", encodeFileName("Foo"))
+
+	for _, file := range []string{"a/b", "..", "%2", "%zz", "%2f", "%61", "Foo"} {
+		_, err := decodeFileName(file)
+		assert.Error(t, err, file)
+	}
+}
+
+func TestFileNameIgnoringCase(t *testing.T) {
+	// Every way of casing the letters of a name, and names with escapes
+	// whose hex digits could be read in either case.
+	var names []string
+	base := "ab-Z_9"
+	for mask := 0; mask < 1<<len(base); mask++ {
+		b := []byte(base)
+		for i := range b {
+			if mask&(1<<i) != 0 {
+				b[i] = strings.ToUpper(string(b[i]))[0]
+			} else {
+				b[i] = strings.ToLower(string(b[i]))[0]
+			}
+		}
+		names = append(names, string(b))
+	}
+	names = append(names, "[x", "[X", "|j", "|J", "ÿ", "ß", "é", "É", "%4a", "%4A")
+	for _, a := range names {
+		for _, b := range names {
+			if a != b && validateFilterName(a) == nil && validateFilterName(b) == nil {
+				assert.False(t, strings.EqualFold(encodeFileName(a), encodeFileName(b)), "%q and %q", a, b)
+			}
+		}
+	}
+}
+
+func TestDumpAndLoadUnsafeNames(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	names := []string{"../../etc/x", "a/b", "..", "名字"}
+	s := NewServer()
+	for _, name := range names {
+		_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: name, Capacity: 100})
+		assert.NoError(t, err)
+		s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: name, Element: "jack"})
+	}
+	assert.NoError(t, s.Dump(dir))
+
+	s = NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, names, report.Recovered)
+	for _, name := range names {
+		assert.True(t, found(s, name, "jack"), name)
+	}
+}
@@ -0,0 +1,369 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	manifestName      = "MANIFEST"
+	manifestVersion   = 1
+	snapshotDirPrefix = "snapshot-"
+	quarantineDirName = "quarantine"
+)
+
+// manifest is the commit point of a snapshot. Dump writes the filter files
+// into a fresh snapshot directory and only then atomically replaces the
+// manifest, so a crash at any point leaves either the old or the new
+// snapshot intact. WALSegment is the first write-ahead log segment holding
+// mutations the snapshot does not cover.
+type manifest struct {
+	Version    int             `json:"version"`
+	Generation uint64          `json:"generation"`
+	Created    time.Time       `json:"created"`
+	Snapshot   string          `json:"snapshot"`
+	WALSegment uint64          `json:"wal_segment"`
+	Filters    []manifestEntry `json:"filters"`
+}
+
+type manifestEntry struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// LoadReport tells which filters Load recovered and which it had to give up on.
+type LoadReport struct {
+	// Recovered lists the filters loaded from the snapshot.
+	Recovered []string
+	// Quarantined lists the filters whose files were missing, truncated or
+	// failed their checksum. Their files are moved to the quarantine
+	// subdirectory.
+	Quarantined []string
+	// Skipped lists the files of a snapshot written before manifests existed
+	// that could not be decoded, such as leftover temporary files.
+	Skipped []string
+	// Replayed is the number of write-ahead log records applied on top.
+	Replayed int
+	// ReplayFailed is the number of those records that failed to apply the
+	// way they originally did, such as inserts that no longer fit.
+	ReplayFailed int
+}
+
+func readManifest(dir string) (*manifest, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, manifestName))
+	if err != nil {
+		return nil, err
+	}
+	m := new(manifest)
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("%s: %v", manifestName, err)
+	}
+	if m.Version > manifestVersion {
+		return nil, fmt.Errorf("%s: unsupported version %d", manifestName, m.Version)
+	}
+	if !strings.HasPrefix(m.Snapshot, snapshotDirPrefix) || m.Snapshot != filepath.Base(m.Snapshot) {
+		return nil, fmt.Errorf("%s: invalid snapshot directory %q", manifestName, m.Snapshot)
+	}
+	return m, nil
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeFile writes data to name and fsyncs it. The caller syncs the directory.
+func writeFile(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Dump writes a snapshot of every filter into dir. The filters are encoded
+// while mutations are held off, so the snapshot is a single point in time.
+// The write-ahead log in dir is truncated to the mutations made after that
+// point.
+func (s *cuckooFilterServer) Dump(dir string) error {
+	s.dumpMu.Lock()
+	defer s.dumpMu.Unlock()
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	walDir := filepath.Join(dir, walDirName)
+
+	type captured struct {
+		header  *snapshotHeader
+		payload []byte
+	}
+	s.persistMu.Lock()
+	filters := s.filters.all()
+	snapshots := make(map[string]captured, len(filters))
+	for k, v := range filters {
+		h, payload := v.snapshot(k)
+		snapshots[k] = captured{h, payload}
+	}
+	var seq uint64
+	var err error
+	if s.wal != nil && s.wal.dir == walDir {
+		seq, err = s.wal.rotate()
+	} else {
+		// The log was replayed by Load but is not written to, so every
+		// segment in it is covered.
+		seq, err = nextSegment(walDir)
+	}
+	s.persistMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	var generation uint64
+	if prev, err := readManifest(dir); err == nil {
+		generation = prev.Generation
+	}
+	generation++
+	m := &manifest{
+		Version:    manifestVersion,
+		Generation: generation,
+		Created:    time.Now().UTC(),
+		Snapshot:   fmt.Sprintf("%s%020d", snapshotDirPrefix, generation),
+		WALSegment: seq,
+		Filters:    make([]manifestEntry, 0, len(snapshots)),
+	}
+
+	snapshotDir := filepath.Join(dir, m.Snapshot)
+	if err := os.RemoveAll(snapshotDir); err != nil {
+		return err
+	}
+	if err := os.Mkdir(snapshotDir, os.ModePerm); err != nil {
+		return err
+	}
+	for k, c := range snapshots {
+		v, err := encodeSnapshot(c.header, c.payload, s.compression)
+		if err != nil {
+			return err
+		}
+		file := encodeFileName(k)
+		if err := writeFile(filepath.Join(snapshotDir, file), v); err != nil {
+			return err
+		}
+		m.Filters = append(m.Filters, manifestEntry{Name: k, File: file, Size: int64(len(v)), SHA256: checksum(v)})
+	}
+	if err := syncDir(snapshotDir); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileSync(filepath.Join(dir, manifestName), b); err != nil {
+		return err
+	}
+
+	if err := removeStaleSnapshots(dir, m.Snapshot); err != nil {
+		return err
+	}
+	return truncateWAL(walDir, seq)
+}
+
+// removeStaleSnapshots removes the snapshot directories other than current,
+// including those of dumps that crashed before committing, and temporary
+// files left behind by crashed writes.
+func removeStaleSnapshots(dir, current string) error {
+	fileInfoList, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fileInfoList {
+		name := fi.Name()
+		stale := fi.IsDir() && strings.HasPrefix(name, snapshotDirPrefix) && name != current ||
+			!fi.IsDir() && strings.HasPrefix(name, manifestName+".tmp-")
+		if stale {
+			if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Load loads the last snapshot in dir and replays the write-ahead log on top
+// of it. Filters whose files fail verification are quarantined and reported
+// rather than failing the whole load.
+func (s *cuckooFilterServer) Load(dir string) (*LoadReport, error) {
+	s.dumpMu.Lock()
+	defer s.dumpMu.Unlock()
+
+	report := new(LoadReport)
+	m, err := readManifest(dir)
+	var filters map[string]*filter
+	switch {
+	case err == nil:
+		filters = s.loadSnapshot(dir, m, report)
+	case os.IsNotExist(err):
+		m = new(manifest)
+		if filters, err = s.loadUnversioned(dir, report); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	s.filters.setAll(filters)
+
+	if report.Replayed, report.ReplayFailed, err = s.replay(filepath.Join(dir, walDirName), m.WALSegment); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (s *cuckooFilterServer) loadSnapshot(dir string, m *manifest, report *LoadReport) map[string]*filter {
+	snapshotDir := filepath.Join(dir, m.Snapshot)
+	filters := make(map[string]*filter, len(m.Filters))
+	for _, e := range m.Filters {
+		f, err := loadEntry(snapshotDir, e)
+		if err != nil {
+			log.Printf("quarantining filter %s: %v", e.Name, err)
+			if err := quarantine(dir, m.Snapshot, e.File); err != nil {
+				log.Printf("failed to quarantine filter %s: %v", e.Name, err)
+			}
+			report.Quarantined = append(report.Quarantined, e.Name)
+			continue
+		}
+		filters[e.Name] = f
+		report.Recovered = append(report.Recovered, e.Name)
+	}
+	return filters
+}
+
+func loadEntry(snapshotDir string, e manifestEntry) (*filter, error) {
+	if name, err := decodeFileName(e.File); err != nil || name != e.Name {
+		return nil, fmt.Errorf("file name %q does not match the filter name", e.File)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(snapshotDir, e.File))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) != e.Size {
+		return nil, fmt.Errorf("size is %d bytes, want %d", len(b), e.Size)
+	}
+	if sum := checksum(b); sum != e.SHA256 {
+		return nil, fmt.Errorf("sha256 is %s, want %s", sum, e.SHA256)
+	}
+	return decodeFilter(b)
+}
+
+// decodeFilter decodes a snapshot file back into a filter.
+func decodeFilter(b []byte) (*filter, error) {
+	h, cf, err := decodeSnapshot(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &filter{cf: cf}
+	f.config = h.Config
+	f.created = h.Created
+	f.modified = h.Modified
+	f.stats = h.Stats
+	return f, nil
+}
+
+func quarantine(dir, snapshot, file string) error {
+	if file != filepath.Base(file) {
+		return fmt.Errorf("invalid file name %q", file)
+	}
+	from := filepath.Join(dir, snapshot, file)
+	if _, err := os.Stat(from); os.IsNotExist(err) {
+		return nil
+	}
+	quarantineDir := filepath.Join(dir, quarantineDirName)
+	if err := os.MkdirAll(quarantineDir, os.ModePerm); err != nil {
+		return err
+	}
+	return os.Rename(from, filepath.Join(quarantineDir, snapshot+"-"+file))
+}
+
+// loadUnversioned loads a directory written before snapshots had a manifest,
+// where every file is a filter named after it. Files that do not decode are
+// skipped.
+func (s *cuckooFilterServer) loadUnversioned(dir string, report *LoadReport) (map[string]*filter, error) {
+	fileInfoList, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]*filter, len(fileInfoList))
+	for i := range fileInfoList {
+		if fileInfoList[i].IsDir() {
+			continue
+		}
+		name := fileInfoList[i].Name()
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := decodeFilter(b)
+		if err != nil {
+			log.Printf("skipping %s: %v", name, err)
+			report.Skipped = append(report.Skipped, name)
+			continue
+		}
+
+		filters[name] = f
+		report.Recovered = append(report.Recovered, name)
+	}
+	return filters, nil
+}
+
+// StartSnapshots dumps the filters into dir every interval until
+// StopSnapshots is called. Failed snapshots are logged and retried on the
+// next tick.
+func (s *cuckooFilterServer) StartSnapshots(dir string, interval time.Duration) {
+	s.dumpWait = make(chan struct{})
+	s.dumpDone = make(chan struct{})
+	go func() {
+		defer close(s.dumpDone)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Dump(dir); err != nil {
+					log.Printf("failed to snapshot filters: %v", err)
+				}
+			case <-s.dumpWait:
+				return
+			}
+		}
+	}()
+}
+
+// StopSnapshots stops the snapshots started by StartSnapshots and waits for
+// one in progress to finish.
+func (s *cuckooFilterServer) StopSnapshots() {
+	if s.dumpWait == nil {
+		return
+	}
+	close(s.dumpWait)
+	<-s.dumpDone
+	s.dumpWait = nil
+}
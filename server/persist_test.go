@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/panmari/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStartSnapshots(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "snapshots")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+
+	s.StartSnapshots(dir, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, manifestName))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	s.StopSnapshots()
+	s.StopSnapshots()
+
+	s = NewServer()
+	_, err = s.Load(dir)
+	assert.NoError(t, err)
+	res, _ := s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.True(t, res.Found)
+}
+
+func TestLoadQuarantinesCorruptFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, s.Dump(dir))
+
+	m, err := readManifest(dir)
+	assert.NoError(t, err)
+	name := filepath.Join(dir, m.Snapshot, "bbb")
+	b, err := ioutil.ReadFile(name)
+	assert.NoError(t, err)
+	b[len(b)-1] ^= 0xff
+	assert.NoError(t, ioutil.WriteFile(name, b, 0644))
+
+	s = NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aaa"}, report.Recovered)
+	assert.Equal(t, []string{"bbb"}, report.Quarantined)
+	assert.True(t, found(s, "aaa", "jack"))
+	_, ok := s.filters.get("bbb")
+	assert.False(t, ok)
+	_, err = os.Stat(filepath.Join(dir, quarantineDirName, m.Snapshot+"-bbb"))
+	assert.NoError(t, err)
+}
+
+func TestLoadKeepsLastCommittedSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, s.Dump(dir))
+
+	// A dump that crashed before committing its manifest.
+	partial := filepath.Join(dir, snapshotDirPrefix+"99999999999999999999")
+	assert.NoError(t, os.Mkdir(partial, os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(partial, "bbb"), []byte("partial"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, manifestName+".tmp-1"), []byte("{"), 0644))
+
+	s = NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aaa"}, report.Recovered)
+
+	assert.NoError(t, s.Dump(dir))
+	_, err = os.Stat(partial)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, manifestName+".tmp-1"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLoadUnversioned(t *testing.T) {
+	f := cuckoo.NewFilter(100)
+	f.Insert([]byte("jack"))
+
+	dir := t.TempDir()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "aaa"), f.Encode(), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "aaa-123456"), []byte("partial"), 0644))
+
+	s := NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aaa"}, report.Recovered)
+	assert.Equal(t, []string{"aaa-123456"}, report.Skipped)
+	assert.True(t, found(s, "aaa", "jack"))
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestClient serves s over an in-memory listener so that streaming RPCs
+// can be exercised end to end.
+func newTestClient(t testing.TB, s *cuckooFilterServer) pb.CuckooFilterClient {
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	pb.RegisterCuckooFilterServer(gs, s)
+	go gs.Serve(lis)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		gs.Stop()
+	})
+	return pb.NewCuckooFilterClient(conn)
+}
+
+// TestConcurrentRPCs hammers every RPC at once. It is meant to be run with
+// -race, which reports any unguarded access to the registry or a filter.
+func TestConcurrentRPCs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	c := newTestClient(t, s)
+
+	names := []string{"aaa", "bbb", "ccc"}
+	for _, name := range names {
+		s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: name, Capacity: 10000})
+	}
+
+	const workers = 8
+	const rounds = 200
+	var wg sync.WaitGroup
+	run := func(fn func(i int, name string)) {
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < rounds; i++ {
+					fn(i, names[(w+i)%len(names)])
+				}
+			}(w)
+		}
+	}
+
+	run(func(i int, name string) {
+		s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: name, Element: strconv.Itoa(i)})
+	})
+	run(func(i int, name string) {
+		s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: name, Elements: []string{strconv.Itoa(i), strconv.Itoa(i + 1)}})
+	})
+	run(func(i int, name string) {
+		s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: name, Element: strconv.Itoa(i)})
+	})
+	run(func(i int, name string) {
+		s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: name, Element: strconv.Itoa(i)})
+	})
+	run(func(i int, name string) {
+		s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: name, Elements: []string{strconv.Itoa(i), strconv.Itoa(i + 1)}})
+	})
+	run(func(i int, name string) {
+		s.CountElements(ctx, &pb.CountElementsRequest{FilterName: name})
+	})
+	run(func(i int, name string) {
+		s.ListFilters(ctx, new(empty.Empty))
+	})
+	run(func(i int, name string) {
+		if i%50 == 0 {
+			s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: name})
+		}
+	})
+	run(func(i int, name string) {
+		tmp := fmt.Sprintf("tmp-%d", i)
+		s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: tmp, Capacity: 100})
+		s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: tmp})
+	})
+	run(func(i int, name string) {
+		if i%50 == 0 {
+			assert.NoError(t, s.Dump(dir))
+			// Load reads a finished snapshot of its own rather than the
+			// data directory the log is being appended to.
+			snapshot := t.TempDir()
+			assert.NoError(t, s.Dump(snapshot))
+			_, err := s.Load(snapshot)
+			assert.NoError(t, err)
+		}
+	})
+	run(func(i int, name string) {
+		if i%20 != 0 {
+			return
+		}
+		stream, err := c.LookupElementsStream(ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+		for j := 0; j < 10; j++ {
+			stream.Send(&pb.LookupElementsStreamRequest{FilterName: name, Element: strconv.Itoa(j)})
+		}
+		stream.CloseSend()
+		for {
+			if _, err := stream.Recv(); err != nil {
+				assert.Equal(t, io.EOF, err)
+				return
+			}
+		}
+	})
+
+	run(func(i int, name string) {
+		if i%50 != 0 {
+			return
+		}
+		first, chunks := export(t, c, name)
+		if first == nil {
+			return
+		}
+		req := &pb.ImportFilterRequest{FilterName: name, Size: first.Size, Sha256: first.Sha256, Replace: true}
+		assert.NoError(t, importFilter(c, req, chunks))
+	})
+
+	wg.Wait()
+
+	res, _ := s.ListFilters(ctx, new(empty.Empty))
+	assert.Subset(t, res.Filters, names)
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// registry maps filter names to filters. Reads load an immutable map through
+// an atomic.Value and never take a lock, so resolving a name on the lookup hot
+// path costs one atomic load and a map access. Writers, which only happen on
+// CreateFilter, DeleteFilter and Load, copy the map under mu and publish the
+// copy.
+type registry struct {
+	mu sync.Mutex
+	v  atomic.Value // map[string]*filter
+}
+
+func newRegistry() *registry {
+	r := &registry{}
+	r.v.Store(make(map[string]*filter))
+	return r
+}
+
+// all returns the current name to filter mapping. It must not be modified.
+func (r *registry) all() map[string]*filter {
+	return r.v.Load().(map[string]*filter)
+}
+
+func (r *registry) get(name string) (*filter, bool) {
+	f, ok := r.all()[name]
+	return f, ok
+}
+
+func (r *registry) names() []string {
+	m := r.all()
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
+// add registers f under name unless the name is already taken.
+func (r *registry) add(name string, f *filter) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.all()
+	if _, ok := old[name]; ok {
+		return false
+	}
+	m := r.copy(old, 1)
+	m[name] = f
+	r.v.Store(m)
+	return true
+}
+
+// set registers f under name, replacing any existing filter.
+func (r *registry) set(name string, f *filter) {
+	r.setAll(map[string]*filter{name: f})
+}
+
+// setAll registers every filter in filters in a single update.
+func (r *registry) setAll(filters map[string]*filter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := r.copy(r.all(), len(filters))
+	for name, f := range filters {
+		m[name] = f
+	}
+	r.v.Store(m)
+}
+
+// remove unregisters f, unless name has been taken by another filter since.
+func (r *registry) remove(name string, f *filter) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.all()
+	if cur, ok := old[name]; !ok || cur != f {
+		return false
+	}
+	m := r.copy(old, 0)
+	delete(m, name)
+	r.v.Store(m)
+	return true
+}
+
+func (r *registry) copy(m map[string]*filter, extra int) map[string]*filter {
+	c := make(map[string]*filter, len(m)+extra)
+	for name, f := range m {
+		c[name] = f
+	}
+	return c
+}
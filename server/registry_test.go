@@ -0,0 +1,35 @@
+package server
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := newRegistry()
+	f1 := newFilter(100)
+	f2 := newFilter(100)
+
+	assert.True(t, r.add("aaa", f1))
+	assert.False(t, r.add("aaa", f2))
+
+	snapshot := r.all()
+	r.set("aaa", f2)
+	r.set("bbb", f1)
+
+	f, ok := r.get("aaa")
+	assert.True(t, ok)
+	assert.Same(t, f2, f)
+	assert.ElementsMatch(t, []string{"aaa", "bbb"}, r.names())
+
+	// Earlier snapshots are never mutated by writers.
+	assert.Len(t, snapshot, 1)
+	assert.Same(t, f1, snapshot["aaa"])
+
+	assert.False(t, r.remove("aaa", f1))
+	assert.True(t, r.remove("aaa", f2))
+	assert.False(t, r.remove("aaa", f2))
+	_, ok = r.get("aaa")
+	assert.False(t, ok)
+	assert.Len(t, r.names(), 1)
+}
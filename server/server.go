@@ -5,158 +5,571 @@ import (
 	"fmt"
 	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
-	"github.com/panmari/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"io"
-	"io/ioutil"
-	"os"
 	"sync"
+	"time"
 )
 
 const maxElementCount = 5000
 
 var (
-	StatusOK                 = &pb.Status{Code: 0, Msg: "OK"}
-	StatusNoFilterFound      = &pb.Status{Code: 1, Msg: "No filter found."}
-	StatusInsertionFailed    = &pb.Status{Code: 2, Msg: "Insertion failed. To increase success rate of inserts, create a larger filter."}
-	StatusNoElementFound     = &pb.Status{Code: 3, Msg: "No element found."}
-	StatusOverLimitation     = &pb.Status{Code: 4, Msg: fmt.Sprintf("Elements amount over %d limitation", maxElementCount)}
-	StatusFilterAlreadyExist = &pb.Status{Code: 5, Msg: "Filter already exist"}
+	StatusOK                  = &pb.Status{Code: 0, Msg: "OK"}
+	StatusNoFilterFound       = &pb.Status{Code: 1, Msg: "No filter found."}
+	StatusInsertionFailed     = &pb.Status{Code: 2, Msg: "Insertion failed. To increase success rate of inserts, create a larger filter."}
+	StatusNoElementFound      = &pb.Status{Code: 3, Msg: "No element found."}
+	StatusOverLimitation      = &pb.Status{Code: 4, Msg: fmt.Sprintf("Elements amount over %d limitation", maxElementCount)}
+	StatusFilterAlreadyExist  = &pb.Status{Code: 5, Msg: "Filter already exist"}
+	StatusInvalidFilterName   = &pb.Status{Code: 6, Msg: "Invalid filter name"}
+	StatusInvalidFilterData   = &pb.Status{Code: 7, Msg: "Invalid filter data"}
+	StatusInvalidFilterConfig = &pb.Status{Code: 8, Msg: "Invalid filter config"}
+	StatusInvalidTTL          = &pb.Status{Code: 9, Msg: "Invalid ttl"}
+	StatusUnsupported         = &pb.Status{Code: 10, Msg: "Operation not supported by the filter"}
+	StatusInvalidValue        = &pb.Status{Code: 11, Msg: "Invalid value"}
+	StatusInvalidElement      = &pb.Status{Code: 12, Msg: "Invalid element"}
+	StatusInvalidHashVersion  = &pb.Status{Code: 13, Msg: "Invalid hash version"}
+	StatusLogFailed           = &pb.Status{Code: 14, Msg: "Failed to log mutation"}
 )
 
+// filter guards a backend, which is not safe for concurrent use.
+// Lookups share the read lock so they run in parallel on the same filter,
+// while inserts, deletes and resets are serialized by the write lock.
+// deleted is set once DeleteFilter has removed the filter from the registry.
+type filter struct {
+	stats    filterStats // first for the alignment of its atomic counters
+	mu       sync.RWMutex
+	cf       backend
+	config   filterConfig
+	created  time.Time
+	modified time.Time
+	deleted  bool
+	// undo records how to roll back the mutation update is applying, if it
+	// is logged.
+	undo *undoLog
+}
+
+// filterConfig holds the settings a filter was created with. It is stored
+// in snapshots, so fields added later must default to the old behaviour.
+// Backend names the backend of the filter, empty for the cuckoo filters
+// created before it was recorded. A zero Capacity means the filter was
+// loaded from a snapshot that did not record it.
+// Growth is the growth factor of a growing filter and zero for a fixed size
+// one. Zero FingerprintBits and BucketSize mean 16 bit fingerprints in
+// buckets of 4, the layout of filters created before they were configurable.
+// FalsePositiveRate is the rate the fingerprint size was chosen for, if any.
+// Window is the length of the window of a windowed filter, split into
+// Slices slices, and zero otherwise. Counting filters count repeated
+// inserts of an element instead of storing it again. Value filters store a
+// value of ValueBits bits with each element.
+type filterConfig struct {
+	Backend           string        `json:"backend,omitempty"`
+	Capacity          uint64        `json:"capacity"`
+	Growth            uint64        `json:"growth,omitempty"`
+	FingerprintBits   uint          `json:"fingerprint_bits,omitempty"`
+	BucketSize        uint          `json:"bucket_size,omitempty"`
+	FalsePositiveRate float64       `json:"false_positive_rate,omitempty"`
+	Window            time.Duration `json:"window,omitempty"`
+	Slices            uint64        `json:"slices,omitempty"`
+	Counting          bool          `json:"counting,omitempty"`
+	ValueBits         uint          `json:"value_bits,omitempty"`
+}
+
+// backend returns the name of the backend of the filter. Filters created
+// before backends were recorded are cuckoo filters.
+func (c filterConfig) backend() string {
+	if c.Backend == "" {
+		return defaultBackend
+	}
+	return c.Backend
+}
+
+func (c filterConfig) sliceLength() int64 {
+	return int64(c.Window) / int64(c.Slices)
+}
+
+// layout returns the fingerprint size and bucket size of the filter.
+func (c filterConfig) layout() (bits, bucketSize uint) {
+	bits, bucketSize = c.FingerprintBits, c.BucketSize
+	if bits == 0 {
+		bits = defaultFingerprintBits
+	}
+	if bucketSize == 0 {
+		bucketSize = defaultBucketSize
+	}
+	return bits, bucketSize
+}
+
+func supported(sizes []uint, size uint) bool {
+	for _, s := range sizes {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
+
+// newFilterConfig checks the settings of a CreateFilter request. It returns
+// the violated field when they are invalid.
+func newFilterConfig(req *pb.CreateFilterRequest) (filterConfig, *errdetails.BadRequest) {
+	config := filterConfig{Capacity: req.Capacity, Counting: req.Counting, ValueBits: uint(req.ValueBits)}
+	if req.Grow {
+		config.Growth = uint64(req.GrowthFactor)
+		if config.Growth == 0 {
+			config.Growth = defaultGrowth
+		}
+		if bad := checkGrowth(config.Growth); bad != nil {
+			return config, bad
+		}
+	}
+
+	if req.Window != nil {
+		if err := req.Window.CheckValid(); err != nil {
+			return config, invalidArgument("window", err)
+		}
+		config.Window = req.Window.AsDuration()
+		config.Slices = uint64(req.WindowSlices)
+		if config.Slices == 0 {
+			config.Slices = defaultWindowSlices
+		}
+		if req.Grow {
+			return config, badRequest("grow", "windowed filters cannot grow")
+		}
+		if bad := checkWindow(config); bad != nil {
+			return config, bad
+		}
+	} else if req.WindowSlices != 0 {
+		return config, badRequest("window_slices", "window slices need a window")
+	}
+
+	config.Backend = req.Backend
+	if config.Backend == "" {
+		config.Backend = defaultBackend
+	}
+	t, ok := backends[config.Backend]
+	if !ok {
+		return config, badRequest("backend", fmt.Sprintf("backend must be one of %v", backendNames()))
+	}
+	if req.FalsePositiveRate != 0 && !(req.FalsePositiveRate > 0 && req.FalsePositiveRate < 1) {
+		return config, badRequest("false_positive_rate", "false positive rate must be between 0 and 1")
+	}
+	config.FalsePositiveRate = req.FalsePositiveRate
+	config.FingerprintBits = uint(req.FingerprintBits)
+	config.BucketSize = uint(req.BucketSize)
+	if bad := t.check(&config); bad != nil {
+		return config, bad
+	}
+	return config, nil
+}
+
+func checkGrowth(growth uint64) *errdetails.BadRequest {
+	if growth < 2 || growth > maxGrowth {
+		return badRequest("growth_factor", fmt.Sprintf("growth factor must be between 2 and %d", maxGrowth))
+	}
+	return nil
+}
+
+// checkWindow checks the slices of a windowed config.
+func checkWindow(config filterConfig) *errdetails.BadRequest {
+	switch {
+	case config.Slices == 0:
+		return badRequest("window_slices", "windowed filters need at least one slice")
+	case config.Slices > maxWindowSlices:
+		return badRequest("window_slices", fmt.Sprintf("window slices must be at most %d", maxWindowSlices))
+	case config.Window < time.Duration(config.Slices)*time.Second:
+		return badRequest("window", "window slices must be at least a second long")
+	}
+	return nil
+}
+
+func createFilter(config filterConfig) *filter {
+	f := &filter{cf: backends[config.backend()].new(config)}
+	f.config = config
+	f.created = time.Now().UTC()
+	f.modified = f.created
+	return f
+}
+
+func (f *filter) lookup(element []byte) bool {
+	f.mu.RLock()
+	found := f.cf.Lookup(element)
+	f.mu.RUnlock()
+	hits := 0
+	if found {
+		hits = 1
+	}
+	f.stats.lookedUp(1, hits)
+	return found
+}
+
+// occurrences counts the insertions of each element; counting them is a
+// lookup as far as the stats go.
+func (f *filter) occurrences(elements elementSet) []uint64 {
+	counts := make([]uint64, elements.len())
+	hits := 0
+	f.mu.RLock()
+	for i := range counts {
+		if counts[i] = uint64(f.cf.Occurrences(elements.at(i))); counts[i] > 0 {
+			hits++
+		}
+	}
+	f.mu.RUnlock()
+	f.stats.lookedUp(len(counts), hits)
+	return counts
+}
+
+func (f *filter) count() uint {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.cf.Count()
+}
+
+// cuckooFilterServer keeps its filters in a registry that is safe for
+// concurrent use; the filters carry their own locks so that work on one
+// filter never blocks another. lifecycleMu serializes CreateFilter and
+// DeleteFilter. Mutations hold persistMu for reading while they apply and
+// log, so that Dump can stop them all for a consistent snapshot. dumpMu keeps
+// Dump and Load from seeing each other's half-written files.
 type cuckooFilterServer struct {
 	pb.UnimplementedCuckooFilterServer
-	Filters  map[string]*cuckoo.Filter
-	mu       sync.Mutex
-	dumpWait chan struct{}
+	filters      *registry
+	lifecycleMu  sync.Mutex
+	persistMu    sync.RWMutex
+	wal          *wal
+	dumpMu       sync.Mutex
+	dumpWait     chan struct{}
+	dumpDone     chan struct{}
+	sweepWait    chan struct{}
+	sweepDone    chan struct{}
+	compression  Compression
+	legacyStatus bool
 }
 
-func NewServer() *cuckooFilterServer {
-	s := &cuckooFilterServer{Filters: make(map[string]*cuckoo.Filter)}
+func NewServer(opts ...Option) *cuckooFilterServer {
+	s := &cuckooFilterServer{filters: newRegistry()}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s
 }
 
+// update applies a mutation to f under its write lock and appends the record
+// fn returns to the write-ahead log before the lock is released, so the log
+// holds the mutations of a filter in the order they were applied. fn returns
+// nil when it changed nothing. Mutations that lose a race with DeleteFilter
+// land on the deleted filter and are not logged.
+//
+// While fn runs, the backend of f records in f.undo how to undo it, and fn
+// records there how to undo what it changes besides, such as the registry.
+// When the append fails, update rolls the mutation back and returns the
+// error, which RPCs report as StatusLogFailed.
+func (s *cuckooFilterServer) update(f *filter, fn func() *walRecord) error {
+	s.persistMu.RLock()
+	defer s.persistMu.RUnlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	logged := !f.deleted && s.wal != nil
+	var undo *undoLog
+	if logged {
+		undo = &undoLog{}
+		f.undo = undo
+		f.cf.journal(undo)
+	}
+	rec := fn()
+	if logged {
+		f.undo = nil
+		undo.stop()
+	}
+	if rec == nil {
+		return nil
+	}
+	f.cf.annotate(rec)
+	if logged {
+		if err := s.wal.append(rec); err != nil {
+			undo.undo()
+			return err
+		}
+	}
+	f.modified = time.Now().UTC()
+	return nil
+}
+
 func (s *cuckooFilterServer) CreateFilter(ctx context.Context, req *pb.CreateFilterRequest) (*pb.CreateFilterResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	filter, ok := s.Filters[req.FilterName]
-	if ok {
-		return &pb.CreateFilterResponse{Status: StatusFilterAlreadyExist}, nil
-	}
-	filter = cuckoo.NewFilter(uint(req.Capacity))
-	s.Filters[req.FilterName] = filter
+	if err := validateFilterName(req.FilterName); err != nil {
+		return &pb.CreateFilterResponse{Status: StatusInvalidFilterName}, s.fail(StatusInvalidFilterName, req.FilterName, invalidArgument("filter_name", err))
+	}
+	config, violation := newFilterConfig(req)
+	if violation != nil {
+		return &pb.CreateFilterResponse{Status: StatusInvalidFilterConfig}, s.fail(StatusInvalidFilterConfig, req.FilterName, violation)
+	}
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+	if _, ok := s.filters.get(req.FilterName); ok {
+		return &pb.CreateFilterResponse{Status: StatusFilterAlreadyExist}, s.fail(StatusFilterAlreadyExist, req.FilterName)
+	}
+	filter := createFilter(config)
+	err := s.update(filter, func() *walRecord {
+		s.filters.add(req.FilterName, filter)
+		filter.undo.record(func() { s.filters.remove(req.FilterName, filter) })
+		return &walRecord{op: opCreateFilter, name: req.FilterName, config: config}
+	})
+	if err != nil {
+		return &pb.CreateFilterResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
 	return &pb.CreateFilterResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) DeleteFilter(ctx context.Context, req *pb.DeleteFilterRequest) (*pb.DeleteFilterResponse, error) {
-	_, ok := s.Filters[req.FilterName]
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.DeleteFilterResponse{Status: StatusNoFilterFound}, nil
+		return &pb.DeleteFilterResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	err := s.update(filter, func() *walRecord {
+		s.filters.remove(req.FilterName, filter)
+		filter.deleted = true
+		filter.undo.record(func() {
+			s.filters.add(req.FilterName, filter)
+			filter.deleted = false
+		})
+		return &walRecord{op: opDeleteFilter, name: req.FilterName}
+	})
+	if err != nil {
+		return &pb.DeleteFilterResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
 	}
-	delete(s.Filters, req.FilterName)
 	return &pb.DeleteFilterResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) ListFilters(ctx context.Context, e *empty.Empty) (*pb.ListFiltersResponse, error) {
-	filterNames := make([]string, 0, len(s.Filters))
-	for key := range s.Filters {
-		filterNames = append(filterNames, key)
-	}
-	return &pb.ListFiltersResponse{Status: StatusOK, Filters: filterNames}, nil
+	return &pb.ListFiltersResponse{Status: StatusOK, Filters: s.filters.names()}, nil
 }
 
 func (s *cuckooFilterServer) InsertElement(ctx context.Context, req *pb.InsertElementRequest) (*pb.InsertElementResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.InsertElementResponse{Status: StatusNoFilterFound}, nil
+		return &pb.InsertElementResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if !filter.cf.supports(canInsert) {
+		return &pb.InsertElementResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canInsert))
+	}
+	expiry, bad := expiryOf(req.Ttl)
+	if bad != nil {
+		return &pb.InsertElementResponse{Status: StatusInvalidTTL}, s.fail(StatusInvalidTTL, req.FilterName, bad)
+	}
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return &pb.InsertElementResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canExpire))
 	}
-	if !filter.Insert([]byte(req.Element)) {
-		return &pb.InsertElementResponse{Status: StatusInsertionFailed}, nil
+	if st, detail := checkValues(filter, "value", req.Value); st != nil {
+		return &pb.InsertElementResponse{Status: st}, s.fail(st, req.FilterName, detail)
+	}
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.InsertElementResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
+	}
+	var inserted bool
+	err := s.update(filter, func() *walRecord {
+		if inserted = filter.insert(element, req.Value, expiry); !inserted {
+			filter.stats.inserted(0, 1)
+			return nil
+		}
+		filter.stats.inserted(1, 0)
+		return &walRecord{op: opInsertElements, name: req.FilterName, elements: [][]byte{element}, expiry: expiry, values: filter.logValues(req.Value)}
+	})
+	if err != nil {
+		return &pb.InsertElementResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	if !inserted {
+		return &pb.InsertElementResponse{Status: StatusInsertionFailed}, s.fail(StatusInsertionFailed, req.FilterName)
 	}
 	return &pb.InsertElementResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) InsertElements(ctx context.Context, req *pb.InsertElementsRequest) (*pb.InsertElementsResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.InsertElementsResponse{Status: StatusNoFilterFound}, nil
+		return &pb.InsertElementsResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	elements := elementSet{req.Elements, req.RawElements}
+	if elements.len() > maxElementCount {
+		return &pb.InsertElementsResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("elements"))
+	}
+	if !filter.cf.supports(canInsert) {
+		return &pb.InsertElementsResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canInsert))
 	}
-	if len(req.Elements) > maxElementCount {
-		return &pb.InsertElementsResponse{Status: StatusOverLimitation}, nil
+	expiry, bad := expiryOf(req.Ttl)
+	if bad != nil {
+		return &pb.InsertElementsResponse{Status: StatusInvalidTTL}, s.fail(StatusInvalidTTL, req.FilterName, bad)
 	}
-	var failedElements = make([]string, 0, maxElementCount)
-	for _, element := range req.Elements {
-		if !filter.Insert([]byte(element)) {
-			failedElements = append(failedElements, element)
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return &pb.InsertElementsResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canExpire))
+	}
+	if len(req.Values) != 0 && len(req.Values) != elements.len() {
+		return &pb.InsertElementsResponse{Status: StatusInvalidValue}, s.fail(StatusInvalidValue, req.FilterName, badRequest("values", "values must be empty or one per element"))
+	}
+	if st, detail := checkValues(filter, "values", req.Values...); st != nil {
+		return &pb.InsertElementsResponse{Status: st}, s.fail(st, req.FilterName, detail)
+	}
+	var failed []int
+	err := s.update(filter, func() *walRecord {
+		inserted := make([][]byte, 0, elements.len())
+		values := make([]uint32, 0, elements.len())
+		for i := 0; i < elements.len(); i++ {
+			var value uint32
+			if len(req.Values) != 0 {
+				value = req.Values[i]
+			}
+			if element := elements.at(i); filter.insert(element, value, expiry) {
+				inserted = append(inserted, element)
+				values = append(values, value)
+			} else {
+				failed = append(failed, i)
+			}
+		}
+		filter.stats.inserted(len(inserted), len(failed))
+		if len(inserted) == 0 {
+			return nil
 		}
+		return &walRecord{op: opInsertElements, name: req.FilterName, elements: inserted, expiry: expiry, values: filter.logValues(values...)}
+	})
+	if err != nil {
+		return &pb.InsertElementsResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
 	}
-	if len(failedElements) > 0 {
-		return &pb.InsertElementsResponse{Status: StatusInsertionFailed, FailedElements: failedElements}, nil
+	if len(failed) > 0 {
+		failedElements, rawFailedElements := elements.pick(failed)
+		err := s.fail(StatusInsertionFailed, req.FilterName, &pb.FailedElements{Elements: failedElements, RawElements: rawFailedElements})
+		return &pb.InsertElementsResponse{Status: StatusInsertionFailed, FailedElements: failedElements, RawFailedElements: rawFailedElements}, err
 	}
 	return &pb.InsertElementsResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) DeleteElement(ctx context.Context, req *pb.DeleteElementRequest) (*pb.DeleteElementResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.DeleteElementResponse{Status: StatusNoFilterFound}, nil
+		return &pb.DeleteElementResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if !filter.cf.supports(canDelete) {
+		return &pb.DeleteElementResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canDelete))
 	}
-	if !filter.Delete([]byte(req.Element)) {
-		return &pb.DeleteElementResponse{Status: StatusNoElementFound}, nil
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.DeleteElementResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
+	}
+	var deleted bool
+	err := s.update(filter, func() *walRecord {
+		if deleted = filter.cf.Delete(element); !deleted {
+			return nil
+		}
+		filter.stats.deleted(1)
+		return &walRecord{op: opDeleteElements, name: req.FilterName, elements: [][]byte{element}}
+	})
+	if err != nil {
+		return &pb.DeleteElementResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	if !deleted {
+		return &pb.DeleteElementResponse{Status: StatusNoElementFound}, s.fail(StatusNoElementFound, req.FilterName)
 	}
 	return &pb.DeleteElementResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) CountElements(ctx context.Context, req *pb.CountElementsRequest) (*pb.CountElementsResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.CountElementsResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	return &pb.CountElementsResponse{Status: StatusOK, Len: uint64(filter.count())}, nil
+}
+
+func (s *cuckooFilterServer) CountElement(ctx context.Context, req *pb.CountElementRequest) (*pb.CountElementResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.CountElementResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.CountElementResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
+	}
+	counts := filter.occurrences(elementSet{raw: [][]byte{element}})
+	return &pb.CountElementResponse{Status: StatusOK, Count: counts[0]}, nil
+}
+
+func (s *cuckooFilterServer) CountEachElement(ctx context.Context, req *pb.CountEachElementRequest) (*pb.CountEachElementResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.CountElementsResponse{Status: StatusNoFilterFound}, nil
+		return &pb.CountEachElementResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	elements := elementSet{req.Elements, req.RawElements}
+	if elements.len() > maxElementCount {
+		return &pb.CountEachElementResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("elements"))
 	}
-	return &pb.CountElementsResponse{Status: StatusOK, Len: uint64(filter.Count())}, nil
+	return &pb.CountEachElementResponse{Status: StatusOK, Counts: filter.occurrences(elements)}, nil
 }
 
 func (s *cuckooFilterServer) ResetFilter(ctx context.Context, req *pb.ResetFilterRequest) (*pb.ResetFilterResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.ResetFilterResponse{Status: StatusNoFilterFound}, nil
+		return &pb.ResetFilterResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if !filter.cf.supports(canReset) {
+		return &pb.ResetFilterResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canReset))
+	}
+	err := s.update(filter, func() *walRecord {
+		filter.cf.Reset()
+		return &walRecord{op: opResetFilter, name: req.FilterName}
+	})
+	if err != nil {
+		return &pb.ResetFilterResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
 	}
-	filter.Reset()
 	return &pb.ResetFilterResponse{Status: StatusOK}, nil
 }
 
 func (s *cuckooFilterServer) LookupElement(ctx context.Context, req *pb.LookupElementRequest) (*pb.LookupElementResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.LookupElementResponse{Status: StatusNoFilterFound}, nil
+		return &pb.LookupElementResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.LookupElementResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
 	}
-	if !filter.Lookup([]byte(req.Element)) {
+	if !filter.lookup(element) {
 		return &pb.LookupElementResponse{Status: StatusNoElementFound}, nil
 	}
-	return &pb.LookupElementResponse{Status: StatusOK}, nil
+	return &pb.LookupElementResponse{Status: StatusOK, Found: true}, nil
 }
 
 func (s *cuckooFilterServer) LookupElements(ctx context.Context, req *pb.LookupElementsRequest) (*pb.LookupElementsResponse, error) {
-	filter, ok := s.Filters[req.FilterName]
+	filter, ok := s.filters.get(req.FilterName)
 	if !ok {
-		return &pb.LookupElementsResponse{Status: StatusNoFilterFound}, nil
+		return &pb.LookupElementsResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
 	}
-	if len(req.Elements) > maxElementCount {
-		return &pb.LookupElementsResponse{Status: StatusOverLimitation}, nil
+	elements := elementSet{req.Elements, req.RawElements}
+	if elements.len() > maxElementCount {
+		return &pb.LookupElementsResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("elements"))
 	}
-	var matchedElements = make([]string, 0, maxElementCount)
-	var unmatchedElements = make([]string, 0, maxElementCount)
-	for _, element := range req.Elements {
-		if filter.Lookup([]byte(element)) {
-			matchedElements = append(matchedElements, element)
+	var matched, unmatched []int
+	filter.mu.RLock()
+	for i := 0; i < elements.len(); i++ {
+		if filter.cf.Lookup(elements.at(i)) {
+			matched = append(matched, i)
 		} else {
-			unmatchedElements = append(unmatchedElements, element)
+			unmatched = append(unmatched, i)
 		}
 	}
-	if len(matchedElements) == 0 {
+	filter.mu.RUnlock()
+	filter.stats.lookedUp(elements.len(), len(matched))
+	if len(matched) == 0 && s.legacyStatus {
 		return &pb.LookupElementsResponse{Status: StatusNoElementFound}, nil
 	}
-	return &pb.LookupElementsResponse{Status: StatusOK, MatchedElements: matchedElements, UnmatchedElements: unmatchedElements}, nil
+	res := &pb.LookupElementsResponse{Status: StatusOK}
+	res.MatchedElements, res.RawMatchedElements = elements.pick(matched)
+	res.UnmatchedElements, res.RawUnmatchedElements = elements.pick(unmatched)
+	return res, nil
 }
 
+// LookupElementsStream answers every request, in order, with its result. A
+// request that fails, as for a filter that does not exist, is answered with
+// its status and does not end the stream. In legacy mode only the elements
+// found are answered, as servers did before.
 func (s *cuckooFilterServer) LookupElementsStream(stream pb.CuckooFilter_LookupElementsStreamServer) error {
 	for {
 		req, err := stream.Recv()
@@ -167,55 +580,24 @@ func (s *cuckooFilterServer) LookupElementsStream(stream pb.CuckooFilter_LookupE
 			return err
 		}
 
-		filter, ok := s.Filters[req.FilterName]
-		if ok && filter.Lookup([]byte(req.Element)) {
-			if err := stream.Send(&pb.LookupElementsStreamResponse{Element: req.Element}); err != nil {
-				return err
-			}
-		}
-	}
-}
-
-func (s *cuckooFilterServer) Dump(dir string) error {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-			return err
-		}
-	}
-	for k, v := range s.Filters {
-		f, err := ioutil.TempFile(dir, k+"-*")
-		if err != nil {
-			return err
-		}
-
-		if _, err := f.Write(v.Encode()); err != nil {
-			return err
+		res := &pb.LookupElementsStreamResponse{Status: StatusOK, Element: req.Element, RawElement: req.RawElement, CorrelationId: req.CorrelationId}
+		filter, ok := s.filters.get(req.FilterName)
+		element, invalid := elementOf(req.Element, req.RawElement)
+		switch {
+		case !ok:
+			res.Status, res.Result = StatusNoFilterFound, pb.LookupResult_LOOKUP_FAILED
+		case invalid != nil:
+			res.Status, res.Result = StatusInvalidElement, pb.LookupResult_LOOKUP_FAILED
+		case filter.lookup(element):
+			res.Result = pb.LookupResult_FOUND
+		default:
+			res.Result = pb.LookupResult_ABSENT
 		}
-
-		f.Close()
-		os.Rename(f.Name(), dir+"/"+k)
-	}
-	return nil
-}
-
-func (s *cuckooFilterServer) Load(dir string) error {
-	fileInfoList, err := ioutil.ReadDir(dir)
-	if err != nil {
-		return err
-	}
-
-	for i := range fileInfoList {
-		b, err := ioutil.ReadFile(dir + "/" + fileInfoList[i].Name())
-		if err != nil {
-			return err
+		if res.Result != pb.LookupResult_FOUND && s.legacyStatus {
+			continue
 		}
-
-		f, err := cuckoo.Decode(b)
-		if err != nil {
+		if err := stream.Send(res); err != nil {
 			return err
 		}
-
-		s.Filters[fileInfoList[i].Name()] = f
 	}
-	return nil
 }
@@ -5,9 +5,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
-	"github.com/panmari/cuckoofilter"
 	"github.com/stretchr/testify/assert"
+	"io"
 	"os"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -27,7 +29,7 @@ func TestCreateFilterAlreadyExist(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.CreateFilterResponse
 	res, _ = s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
@@ -39,7 +41,7 @@ func TestDeleteFilter(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.DeleteFilterResponse
 	res, _ = s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "aaa"})
@@ -52,7 +54,7 @@ func TestDeleteFilterNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.DeleteFilterResponse
 	res, _ = s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "bbb"})
@@ -77,8 +79,8 @@ func TestListFilterEmpty(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
-	s.Filters["bbb"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
+	s.filters.set("bbb", newFilter(100))
 
 	var res *pb.ListFiltersResponse
 	res, _ = s.ListFilters(ctx, new(empty.Empty))
@@ -91,7 +93,7 @@ func TestInsertElement(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.InsertElementResponse
 	res, _ = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
@@ -104,7 +106,7 @@ func TestInsertElementNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.InsertElementResponse
 	res, _ = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
@@ -117,9 +119,9 @@ func TestDeleteElement(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.DeleteElementResponse
 	res, _ = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
@@ -132,9 +134,9 @@ func TestDeleteElementNoElementFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.DeleteElementResponse
 	res, _ = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "mary"})
@@ -147,9 +149,9 @@ func TestDeleteElementNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.DeleteElementResponse
 	res, _ = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "bbb", Element: "jack"})
@@ -162,10 +164,10 @@ func TestCountElements(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
-	filter.Insert([]byte("mary"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
+	filter.cf.Insert([]byte("mary"))
 
 	var res *pb.CountElementsResponse
 	res, _ = s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
@@ -178,10 +180,10 @@ func TestCountElementsNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
-	filter.Insert([]byte("mary"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
+	filter.cf.Insert([]byte("mary"))
 
 	var res *pb.CountElementsResponse
 	res, _ = s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "bbb"})
@@ -194,14 +196,14 @@ func TestResetFilter(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
-	filter.Insert([]byte("mary"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
+	filter.cf.Insert([]byte("mary"))
 
 	s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "aaa"})
 
-	assert.Zero(t, filter.Count())
+	assert.Zero(t, filter.count())
 }
 
 func TestResetFilterNoFilterFound(t *testing.T) {
@@ -209,10 +211,10 @@ func TestResetFilterNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
-	filter.Insert([]byte("mary"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
+	filter.cf.Insert([]byte("mary"))
 
 	var res *pb.ResetFilterResponse
 	res, _ = s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "bbb"})
@@ -225,9 +227,9 @@ func TestLookupElement(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.LookupElementResponse
 	res, _ = s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "jack"})
@@ -240,9 +242,9 @@ func TestLookupElementNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.LookupElementResponse
 	res, _ = s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "bbb", Element: "jack"})
@@ -255,9 +257,9 @@ func TestLookupElementNoElementFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.LookupElementResponse
 	res, _ = s.LookupElement(ctx, &pb.LookupElementRequest{FilterName: "aaa", Element: "mary"})
@@ -270,9 +272,9 @@ func TestLookupElements(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.LookupElementsResponse
 	res, _ = s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"jack", "rose"}})
@@ -287,9 +289,9 @@ func TestLookupElementsNoFilterFound(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte("jack"))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte("jack"))
 
 	var res *pb.LookupElementsResponse
 	res, _ = s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "bbb", Elements: []string{"jack"}})
@@ -302,9 +304,9 @@ func TestLookupElementsOverLimitation(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	filter := cuckoo.NewFilter(100)
-	s.Filters["aaa"] = filter
-	filter.Insert([]byte(""))
+	filter := newFilter(100)
+	s.filters.set("aaa", filter)
+	filter.cf.Insert([]byte(""))
 
 	var res *pb.LookupElementsResponse
 	elements := make([]string, 5000)
@@ -321,7 +323,7 @@ func TestInsertElements(t *testing.T) {
 	defer cancel()
 
 	s := NewServer()
-	s.Filters["aaa"] = cuckoo.NewFilter(100)
+	s.filters.set("aaa", newFilter(100))
 
 	var res *pb.InsertElementsResponse
 	res, _ = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack"}})
@@ -330,39 +332,117 @@ func TestInsertElements(t *testing.T) {
 }
 
 func TestLookupElementsStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.filters.set("aaa", newFilter(100))
+	s.filters.set("bbb", newFilter(100))
+	c := newTestClient(t, s)
+	_, err := c.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary"}})
+	assert.NoError(t, err)
+
+	reqs := []*pb.LookupElementsStreamRequest{
+		{FilterName: "aaa", Element: "jack", CorrelationId: 10},
+		{FilterName: "aaa", Element: "tom", CorrelationId: 11},
+		{FilterName: "ccc", Element: "jack", CorrelationId: 12},
+		{FilterName: "aaa", Element: "mary", RawElement: []byte("mary"), CorrelationId: 13},
+		{FilterName: "bbb", Element: "jack", CorrelationId: 14},
+		{FilterName: "aaa", RawElement: []byte("mary"), CorrelationId: 15},
+	}
+	want := []struct {
+		st     *pb.Status
+		result pb.LookupResult
+	}{
+		{StatusOK, pb.LookupResult_FOUND},
+		{StatusOK, pb.LookupResult_ABSENT},
+		{StatusNoFilterFound, pb.LookupResult_LOOKUP_FAILED},
+		{StatusInvalidElement, pb.LookupResult_LOOKUP_FAILED},
+		{StatusOK, pb.LookupResult_ABSENT},
+		{StatusOK, pb.LookupResult_FOUND},
+	}
+
+	stream, err := c.LookupElementsStream(ctx)
+	assert.NoError(t, err)
+	for _, req := range reqs {
+		assert.NoError(t, stream.Send(req))
+	}
+	assert.NoError(t, stream.CloseSend())
+	for i, req := range reqs {
+		res, err := stream.Recv()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, req.CorrelationId, res.CorrelationId)
+		assert.Equal(t, req.Element, res.Element)
+		assert.Equal(t, req.RawElement, res.RawElement)
+		assert.Equal(t, want[i].st.Code, res.Status.Code, req.CorrelationId)
+		assert.Equal(t, want[i].result, res.Result, req.CorrelationId)
+	}
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestLookupElementsStreamLegacy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
+	// Legacy clients are only sent the elements found.
+	s := NewServer(WithLegacyStatus())
+	s.filters.set("aaa", newFilter(100))
+	c := newTestClient(t, s)
+	_, err := c.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+	assert.NoError(t, err)
+	stream, err := c.LookupElementsStream(ctx)
+	assert.NoError(t, err)
+	for _, req := range []*pb.LookupElementsStreamRequest{
+		{FilterName: "aaa", Element: "jack", CorrelationId: 10},
+		{FilterName: "ccc", Element: "mary", CorrelationId: 11},
+		{FilterName: "aaa", Element: "mary", RawElement: []byte("mary"), CorrelationId: 12},
+		{FilterName: "aaa", RawElement: []byte("mary"), CorrelationId: 15},
+	} {
+		assert.NoError(t, stream.Send(req))
+	}
+	assert.NoError(t, stream.CloseSend())
+	res, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(15), res.CorrelationId)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
 }
 
 func TestDumpAndLoad(t *testing.T) {
-	f1 := cuckoo.NewFilter(3)
-	f1.Insert([]byte("a"))
-	f1.Insert([]byte("b"))
-	f1.Insert([]byte("c"))
+	f1 := newFilter(3)
+	f1.cf.Insert([]byte("a"))
+	f1.cf.Insert([]byte("b"))
+	f1.cf.Insert([]byte("c"))
 
-	f2 := cuckoo.NewFilter(3)
-	f2.Insert([]byte("x"))
-	f2.Insert([]byte("y"))
-	f2.Insert([]byte("z"))
+	f2 := newFilter(3)
+	f2.cf.Insert([]byte("x"))
+	f2.cf.Insert([]byte("y"))
+	f2.cf.Insert([]byte("z"))
 
 	s := NewServer()
-	s.Filters["aaa"] = f1
-	s.Filters["bbb"] = f2
+	s.filters.set("aaa", f1)
+	s.filters.set("bbb", f2)
 
 	dir := "dump"
 	assert.NoError(t, s.Dump(dir))
 
 	s = NewServer()
 	s.Load(dir)
+	aaa, _ := s.filters.get("aaa")
+	bbb, _ := s.filters.get("bbb")
 
-	assert.True(t, s.Filters["aaa"].Lookup([]byte("a")))
-	assert.True(t, s.Filters["aaa"].Lookup([]byte("b")))
-	assert.True(t, s.Filters["aaa"].Lookup([]byte("c")))
-	assert.False(t, s.Filters["aaa"].Lookup([]byte("d")))
+	assert.True(t, aaa.cf.Lookup([]byte("a")))
+	assert.True(t, aaa.cf.Lookup([]byte("b")))
+	assert.True(t, aaa.cf.Lookup([]byte("c")))
+	assert.False(t, aaa.cf.Lookup([]byte("d")))
 
-	assert.True(t, s.Filters["bbb"].Lookup([]byte("x")))
-	assert.True(t, s.Filters["bbb"].Lookup([]byte("y")))
-	assert.True(t, s.Filters["bbb"].Lookup([]byte("z")))
-	assert.False(t, s.Filters["bbb"].Lookup([]byte("w")))
+	assert.True(t, bbb.cf.Lookup([]byte("x")))
+	assert.True(t, bbb.cf.Lookup([]byte("y")))
+	assert.True(t, bbb.cf.Lookup([]byte("z")))
+	assert.False(t, bbb.cf.Lookup([]byte("w")))
 
 	os.RemoveAll(dir)
 }
@@ -379,6 +459,19 @@ func BenchmarkLookupElement100Million(b *testing.B) { benchmarkLookupElement("aa
 
 //func BenchmarkLookupElement1000Million(b *testing.B) { benchmarkLookupElement("aaa", 1000000000, b) }
 
+func BenchmarkLookupElementParallel1Million(b *testing.B) {
+	benchmarkLookupElementParallel("aaa", 1000000, b)
+}
+func BenchmarkLookupElementParallel10Million(b *testing.B) {
+	benchmarkLookupElementParallel("aaa", 10000000, b)
+}
+func BenchmarkLookupElementParallel100Million(b *testing.B) {
+	benchmarkLookupElementParallel("aaa", 100000000, b)
+}
+
+func BenchmarkLookupElementParallel100Filters(b *testing.B)  { benchmarkLookupElementFilters(100, b) }
+func BenchmarkLookupElementParallel1000Filters(b *testing.B) { benchmarkLookupElementFilters(1000, b) }
+
 func benchmarkLookupElement(filterName string, filterCapacity uint, b *testing.B) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -389,12 +482,49 @@ func benchmarkLookupElement(filterName string, filterCapacity uint, b *testing.B
 	}
 }
 
+func benchmarkLookupElementParallel(filterName string, filterCapacity uint, b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s, lastElement := load(filterName, filterCapacity)
+	b.ResetTimer()
+	b.RunParallel(func(p *testing.PB) {
+		req := &pb.LookupElementRequest{FilterName: filterName, Element: lastElement}
+		for p.Next() {
+			s.LookupElement(ctx, req)
+		}
+	})
+}
+
+// benchmarkLookupElementFilters spreads parallel lookups over many filters,
+// which is where a single registry lock used to become the bottleneck.
+func benchmarkLookupElementFilters(filterCount int, b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s := NewServer()
+	reqs := make([]*pb.LookupElementRequest, filterCount)
+	for i := range reqs {
+		name := strconv.Itoa(i)
+		s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: name, Capacity: 1000})
+		s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: name, Element: name})
+		reqs[i] = &pb.LookupElementRequest{FilterName: name, Element: name}
+	}
+	var next uint32
+	b.ResetTimer()
+	b.RunParallel(func(p *testing.PB) {
+		i := int(atomic.AddUint32(&next, 7919))
+		for p.Next() {
+			s.LookupElement(ctx, reqs[i%filterCount])
+			i++
+		}
+	})
+}
+
 func load(filterName string, filterCapacity uint) (s *cuckooFilterServer, lastElement string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	s = NewServer()
-	s.Filters[filterName] = cuckoo.NewFilter(filterCapacity)
+	s.filters.set(filterName, newFilter(filterCapacity))
 
 	var i uint
 	for i = 0; i < filterCapacity-1; i++ {
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/snappy"
+	"io/ioutil"
+	"time"
+)
+
+// Compression selects how the filter payload of a snapshot file is compressed.
+type Compression int
+
+const (
+	// CompressionNone stores the payload as is.
+	CompressionNone Compression = iota
+	// CompressionGzip trades CPU for the smallest files.
+	CompressionGzip
+	// CompressionSnappy compresses less but is fast enough to not slow
+	// snapshots down.
+	CompressionSnappy
+)
+
+var compressionNames = map[Compression]string{
+	CompressionNone:   "none",
+	CompressionGzip:   "gzip",
+	CompressionSnappy: "snappy",
+}
+
+func (c Compression) String() string {
+	return compressionNames[c]
+}
+
+// ParseCompression parses "none", "gzip" or "snappy".
+func ParseCompression(s string) (Compression, error) {
+	for c, name := range compressionNames {
+		if name == s {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown snapshot compression %q", s)
+}
+
+// WithSnapshotCompression makes Dump compress the filters it writes with c.
+// Load reads every compression regardless.
+func WithSnapshotCompression(c Compression) Option {
+	return func(s *cuckooFilterServer) {
+		s.compression = c
+	}
+}
+
+// A snapshot file starts with snapshotMagic, a little-endian uint16 format
+// version and a uint32 header length, followed by the JSON encoded header and
+// the filter payload. Files without the magic are raw panmari/cuckoofilter
+// output as written before the format was versioned.
+const (
+	snapshotMagic   = "CKFS"
+	snapshotVersion = 1
+	snapshotPrefix  = len(snapshotMagic) + 2 + 4
+	maxHeaderSize   = 1 << 20
+)
+
+// snapshotHeader describes the filter in a snapshot file. Fields added later
+// must be optional so that older files keep loading. Backends fill in the
+// fields from Count on that decoding their payload needs.
+type snapshotHeader struct {
+	Name        string       `json:"name"`
+	Config      filterConfig `json:"config"`
+	Created     time.Time    `json:"created"`
+	Modified    time.Time    `json:"modified"`
+	Stats       filterStats  `json:"stats"`
+	Count       uint         `json:"count"`
+	Buckets     []uint64     `json:"buckets"`
+	Slices      []int64      `json:"slices,omitempty"`
+	Expiring    []int        `json:"expiring,omitempty"`
+	Hashes      uint         `json:"hashes,omitempty"`
+	Seed        uint64       `json:"seed,omitempty"`
+	Compression string       `json:"compression"`
+	PayloadSize int          `json:"payload_size"`
+}
+
+// snapshot captures the payload and header of a snapshot file of f. It is
+// cheap next to compressing the payload, which encodeSnapshot does later
+// without holding up mutations.
+func (f *filter) snapshot(name string) (*snapshotHeader, []byte) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	h := &snapshotHeader{
+		Name:     name,
+		Config:   f.config,
+		Created:  f.created,
+		Modified: f.modified,
+		Stats:    f.stats.load(),
+	}
+	payload := f.cf.snapshot(h)
+	h.PayloadSize = len(payload)
+	return h, payload
+}
+
+// encodeSnapshot encodes a snapshot file holding payload compressed with c.
+func encodeSnapshot(h *snapshotHeader, payload []byte, c Compression) ([]byte, error) {
+	h.Compression = c.String()
+	header, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(snapshotMagic)
+	var b [6]byte
+	binary.LittleEndian.PutUint16(b[0:], snapshotVersion)
+	binary.LittleEndian.PutUint32(b[2:], uint32(len(header)))
+	buf.Write(b[:])
+	buf.Write(header)
+
+	switch c {
+	case CompressionNone:
+		buf.Write(payload)
+	case CompressionGzip:
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case CompressionSnappy:
+		buf.Write(snappy.Encode(nil, payload))
+	default:
+		return nil, fmt.Errorf("unknown snapshot compression %d", c)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeSnapshot decodes a snapshot file, or raw filter data written before
+// the format was versioned.
+func decodeSnapshot(b []byte) (*snapshotHeader, backend, error) {
+	if !bytes.HasPrefix(b, []byte(snapshotMagic)) {
+		cf, err := decodeCuckooFilter(b, defaultFingerprintBits, defaultBucketSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &snapshotHeader{Count: cf.Count(), Buckets: []uint64{cf.buckets}, PayloadSize: len(b)}, &chain{filters: []*cuckooFilter{cf}}, nil
+	}
+	if len(b) < snapshotPrefix {
+		return nil, nil, errors.New("truncated snapshot header")
+	}
+	version := binary.LittleEndian.Uint16(b[len(snapshotMagic):])
+	if version != snapshotVersion {
+		return nil, nil, fmt.Errorf("unsupported snapshot version %d", version)
+	}
+	size := binary.LittleEndian.Uint32(b[len(snapshotMagic)+2:])
+	if size > maxHeaderSize || int(size) > len(b)-snapshotPrefix {
+		return nil, nil, errors.New("truncated snapshot header")
+	}
+	h := new(snapshotHeader)
+	if err := json.Unmarshal(b[snapshotPrefix:snapshotPrefix+int(size)], h); err != nil {
+		return nil, nil, fmt.Errorf("snapshot header: %v", err)
+	}
+
+	payload := b[snapshotPrefix+int(size):]
+	var err error
+	switch h.Compression {
+	case "", "none":
+	case "gzip":
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(payload)); err == nil {
+			payload, err = ioutil.ReadAll(r)
+		}
+	case "snappy":
+		payload, err = snappy.Decode(nil, payload)
+	default:
+		err = fmt.Errorf("unknown compression %q", h.Compression)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("snapshot payload: %v", err)
+	}
+	if len(payload) != h.PayloadSize {
+		return nil, nil, fmt.Errorf("snapshot payload is %d bytes, want %d", len(payload), h.PayloadSize)
+	}
+	t, ok := backends[h.Config.backend()]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown backend %q", h.Config.Backend)
+	}
+	cf, err := t.decode(h, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h, cf, nil
+}
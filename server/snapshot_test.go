@@ -0,0 +1,314 @@
+package server
+
+import (
+	"context"
+	"flag"
+	metro "github.com/dgryski/go-metro"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "write the golden snapshot files missing from testdata")
+
+var goldenElements = []string{"a", "b", "c", "d", "e"}
+
+// goldenTime is when the golden filters were created and last modified.
+var goldenTime = time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func goldenFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100})
+	f.created, f.modified = goldenTime, goldenTime
+	for _, e := range goldenElements {
+		f.cf.Insert([]byte(e))
+	}
+	return f
+}
+
+func goldenGrowingFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 8, Growth: 2})
+	f.created, f.modified = goldenTime, goldenTime
+	for i := 0; i < 100; i++ {
+		f.cf.Insert([]byte(strconv.Itoa(i)))
+	}
+	return f
+}
+
+func goldenLayoutFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100, FingerprintBits: 8, BucketSize: 2, FalsePositiveRate: 0.05})
+	f.created, f.modified = goldenTime, goldenTime
+	for _, e := range goldenElements {
+		f.cf.Insert([]byte(e))
+	}
+	return f
+}
+
+// goldenWindowFilter holds goldenElements in two slices of 2021-01-02.
+func goldenWindowFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100, Window: time.Hour, Slices: 2})
+	f.created, f.modified = goldenTime, goldenTime
+	slice := f.created.UnixNano() / f.config.sliceLength()
+	for i, e := range goldenElements {
+		f.cf.(*chain).advance(slice + int64(i%2))
+		f.cf.(*chain).insertHash(pb.HashString(e), 0)
+	}
+	return f
+}
+
+// goldenTTLFilter holds goldenElements expiring a minute apart from
+// 2021-01-02, but for the first, which never expires.
+func goldenTTLFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100})
+	f.created, f.modified = goldenTime, goldenTime
+	for i, e := range goldenElements {
+		var expiry uint32
+		if i > 0 {
+			expiry = expiryAt(f.created.Add(time.Duration(i) * time.Minute))
+		}
+		f.cf.InsertUntil([]byte(e), expiry)
+	}
+	return f
+}
+
+// goldenCountingFilter counts goldenElements inserted once more each than
+// the one before.
+func goldenCountingFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100, Counting: true})
+	f.created, f.modified = goldenTime, goldenTime
+	for i, e := range goldenElements {
+		for j := 0; j <= i; j++ {
+			f.cf.Insert([]byte(e))
+		}
+	}
+	return f
+}
+
+func goldenXorFilter() *filter {
+	hashes := make([]uint64, len(goldenElements))
+	for i, e := range goldenElements {
+		hashes[i] = metro.Hash64([]byte(e), hashSeed)
+	}
+	xf, _ := buildXorFilter(hashes, 8)
+	f := &filter{cf: xf, config: filterConfig{Backend: "xor", Capacity: uint64(xf.count), FingerprintBits: 8}}
+	f.created, f.modified = goldenTime, goldenTime
+	return f
+}
+
+func goldenBloomFilter() *filter {
+	f := createFilter(filterConfig{Backend: "bloom", Capacity: 100, FalsePositiveRate: 0.01})
+	f.created, f.modified = goldenTime, goldenTime
+	for _, e := range goldenElements {
+		f.cf.Insert([]byte(e))
+	}
+	return f
+}
+
+// goldenValueFilter maps goldenElements to their positions in it.
+func goldenValueFilter() *filter {
+	f := createFilter(filterConfig{Capacity: 100, ValueBits: 8})
+	f.created, f.modified = goldenTime, goldenTime
+	for i, e := range goldenElements {
+		f.cf.InsertValue([]byte(e), uint16(i), 0)
+	}
+	return f
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	for _, c := range []Compression{CompressionNone, CompressionGzip, CompressionSnappy} {
+		src := goldenFilter()
+		h, payload := src.snapshot("aaa")
+		b, err := encodeSnapshot(h, payload, c)
+		assert.NoError(t, err, c)
+
+		got, cf, err := decodeSnapshot(b)
+		assert.NoError(t, err, c)
+		assert.Equal(t, "aaa", got.Name)
+		assert.Equal(t, c.String(), got.Compression)
+		assert.Equal(t, src.config, got.Config)
+		assert.True(t, src.created.Equal(got.Created))
+		assert.Equal(t, src.cf.(*chain).Encode(), cf.(*chain).Encode())
+	}
+}
+
+func TestSnapshotCorrupt(t *testing.T) {
+	h, payload := goldenFilter().snapshot("aaa")
+	b, err := encodeSnapshot(h, payload, CompressionSnappy)
+	assert.NoError(t, err)
+
+	for _, bad := range [][]byte{
+		b[:snapshotPrefix-1],
+		b[:snapshotPrefix+10],
+		b[:len(b)-1],
+		append([]byte(snapshotMagic+"\xff\xff"), b[len(snapshotMagic)+2:]...),
+	} {
+		_, _, err := decodeSnapshot(bad)
+		assert.Error(t, err)
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	for _, c := range []Compression{CompressionNone, CompressionGzip, CompressionSnappy} {
+		parsed, err := ParseCompression(c.String())
+		assert.NoError(t, err)
+		assert.Equal(t, c, parsed)
+	}
+	_, err := ParseCompression("zstd")
+	assert.Error(t, err)
+}
+
+func missing(name string) bool {
+	_, err := os.Stat(name)
+	return os.IsNotExist(err)
+}
+
+// lookupsGolden checks that f holds goldenElements.
+func lookupsGolden(t *testing.T, f *filter) {
+	for _, e := range goldenElements {
+		assert.True(t, f.cf.Lookup([]byte(e)), e)
+	}
+}
+
+// goldenSnapshots are the golden snapshot files of the current format, each
+// holding the filter it was written from.
+var goldenSnapshots = []struct {
+	file        string
+	filter      func() *filter
+	compression Compression
+	// relocated is set for filters built with random relocations, whose
+	// payload differs each time.
+	relocated bool
+	// check checks what the filter answers beyond its snapshot.
+	check func(t *testing.T, f *filter)
+}{
+	{"v1-none", goldenFilter, CompressionNone, false, lookupsGolden},
+	{"v1-gzip", goldenFilter, CompressionGzip, false, lookupsGolden},
+	{"v1-snappy", goldenFilter, CompressionSnappy, false, lookupsGolden},
+	{"v1-grow", goldenGrowingFilter, CompressionNone, true, func(t *testing.T, f *filter) {
+		for i := 0; i < 100; i++ {
+			assert.True(t, f.cf.Lookup([]byte(strconv.Itoa(i))), i)
+		}
+	}},
+	{"v1-layout", goldenLayoutFilter, CompressionNone, false, lookupsGolden},
+	{"v1-window", goldenWindowFilter, CompressionNone, false, func(t *testing.T, f *filter) {
+		assert.Equal(t, uint(len(goldenElements)), f.cf.(*chain).stored())
+	}},
+	{"v1-ttl", goldenTTLFilter, CompressionNone, false, func(t *testing.T, f *filter) {
+		assert.True(t, f.cf.Lookup([]byte(goldenElements[0])))
+		assert.False(t, f.cf.Lookup([]byte(goldenElements[1])))
+	}},
+	{"v1-counting", goldenCountingFilter, CompressionNone, false, func(t *testing.T, f *filter) {
+		for i, e := range goldenElements {
+			assert.Equal(t, uint(i+1), f.cf.Occurrences([]byte(e)), e)
+		}
+	}},
+	{"v1-bloom", goldenBloomFilter, CompressionNone, false, lookupsGolden},
+	{"v1-xor", goldenXorFilter, CompressionNone, false, lookupsGolden},
+	{"v1-values", goldenValueFilter, CompressionNone, false, func(t *testing.T, f *filter) {
+		for i, e := range goldenElements {
+			value, ok := f.cf.Value([]byte(e))
+			assert.True(t, ok, e)
+			assert.Equal(t, uint16(i), value, e)
+		}
+	}},
+}
+
+// TestSnapshotGolden keeps the snapshot files written by every released
+// format loadable: v0, raw filter data written before snapshots had a header,
+// and the files of goldenSnapshots. When the format changes, bump
+// snapshotVersion, add files for it to goldenSnapshots and run with -update,
+// which only writes files that do not exist yet.
+func TestSnapshotGolden(t *testing.T) {
+	dir := filepath.Join("testdata", "snapshot")
+	if *update {
+		assert.NoError(t, os.MkdirAll(dir, os.ModePerm))
+		if name := filepath.Join(dir, "v0"); missing(name) {
+			assert.NoError(t, ioutil.WriteFile(name, goldenFilter().cf.(*chain).Encode(), 0644))
+		}
+		for _, g := range goldenSnapshots {
+			if name := filepath.Join(dir, g.file); missing(name) {
+				h, payload := g.filter().snapshot("aaa")
+				b, err := encodeSnapshot(h, payload, g.compression)
+				assert.NoError(t, err, g.file)
+				assert.NoError(t, ioutil.WriteFile(name, b, 0644))
+			}
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "v0"))
+	assert.NoError(t, err)
+	f, err := decodeFilter(b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, goldenFilter().cf.(*chain).Encode(), f.cf.(*chain).Encode())
+		lookupsGolden(t, f)
+	}
+
+	for _, g := range goldenSnapshots {
+		b, err := ioutil.ReadFile(filepath.Join(dir, g.file))
+		if !assert.NoError(t, err, g.file) {
+			continue
+		}
+		f, err := decodeFilter(b)
+		if !assert.NoError(t, err, g.file) {
+			continue
+		}
+		// Snapshotting the decoded filter again gives back the snapshot of
+		// the filter the file was written from.
+		wantHeader, wantPayload := g.filter().snapshot("aaa")
+		header, payload := f.snapshot("aaa")
+		assert.Equal(t, wantHeader, header, g.file)
+		if !g.relocated {
+			assert.Equal(t, wantPayload, payload, g.file)
+		}
+		g.check(t, f)
+	}
+}
+
+// TestDataDirGolden loads a data directory written by Dump, with its
+// manifest and write-ahead log, the way the server finds it at startup.
+func TestDataDirGolden(t *testing.T) {
+	golden := filepath.Join("testdata", "datadir-v1")
+	if *update && missing(golden) {
+		s := NewServer(WithSnapshotCompression(CompressionSnappy))
+		s.filters.set("aaa", goldenFilter())
+		assert.NoError(t, s.Dump(golden))
+		assert.NoError(t, s.OpenWAL(golden, SyncAlways))
+		s.InsertElement(context.Background(), &pb.InsertElementRequest{FilterName: "aaa", Element: "f"})
+		assert.NoError(t, s.CloseWAL())
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, copyDir(golden, dir))
+	s := NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aaa"}, report.Recovered)
+	assert.Equal(t, 1, report.Replayed)
+	for _, e := range append(goldenElements, "f") {
+		assert.True(t, found(s, "aaa", e), e)
+	}
+}
+
+func copyDir(from, to string) error {
+	return filepath.Walk(from, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return os.MkdirAll(filepath.Join(to, rel), os.ModePerm)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(to, rel), b, 0644)
+	})
+}
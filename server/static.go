@@ -0,0 +1,87 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"time"
+)
+
+// BuildStaticFilter builds an xor filter from every element of the stream
+// and registers it once the stream ends. Elements are hashed as they arrive,
+// so the server holds 8 bytes per element until the filter is built. The
+// filter is logged whole, like an imported one.
+func (s *cuckooFilterServer) BuildStaticFilter(stream pb.CuckooFilter_BuildStaticFilterServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return s.buildFailed(stream, StatusInvalidFilterName, "", invalidArgument("filter_name", errors.New("no request received")))
+	}
+	if err != nil {
+		return err
+	}
+	name := first.FilterName
+	if err := validateFilterName(name); err != nil {
+		return s.buildFailed(stream, StatusInvalidFilterName, name, invalidArgument("filter_name", err))
+	}
+	fingerprintBits := uint(first.FingerprintBits)
+	if fingerprintBits == 0 {
+		fingerprintBits = defaultStaticFingerprintBits
+	}
+	if !supported(staticFingerprintSizes, fingerprintBits) {
+		return s.buildFailed(stream, StatusInvalidFilterConfig, name, badRequest("fingerprint_bits", fmt.Sprintf("fingerprint bits must be one of %v", staticFingerprintSizes)))
+	}
+	if _, ok := s.filters.get(name); ok && !first.Replace {
+		return s.buildFailed(stream, StatusFilterAlreadyExist, name)
+	}
+
+	var hashes []uint64
+	for req := first; ; {
+		elements := elementSet{req.Elements, req.RawElements}
+		if elements.len() > maxElementCount || len(hashes)+elements.len() > maxStaticElements {
+			return s.buildFailed(stream, StatusOverLimitation, name, overLimitation("elements"))
+		}
+		for i := 0; i < elements.len(); i++ {
+			hashes = append(hashes, pb.HashElement(elements.at(i)))
+		}
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	xf, err := buildXorFilter(hashes, fingerprintBits)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	filter := &filter{cf: xf}
+	filter.config = filterConfig{Backend: "xor", Capacity: uint64(xf.count), FingerprintBits: fingerprintBits}
+	filter.created = time.Now().UTC()
+	filter.modified = filter.created
+	filter.stats.inserted(int(xf.count), 0)
+	h, payload := filter.snapshot(name)
+	b, err := encodeSnapshot(h, payload, CompressionNone)
+	if err != nil {
+		return err
+	}
+	st, err := s.install(name, filter, b, first.Replace)
+	if err != nil {
+		return s.buildFailed(stream, st, name, logFailure(err))
+	}
+	if st != StatusOK {
+		return s.buildFailed(stream, st, name)
+	}
+	return stream.SendAndClose(&pb.BuildStaticFilterResponse{Status: StatusOK, Count: uint64(xf.count)})
+}
+
+func (s *cuckooFilterServer) buildFailed(stream pb.CuckooFilter_BuildStaticFilterServer, st *pb.Status, filterName string, details ...proto.Message) error {
+	if err := s.fail(st, filterName, details...); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.BuildStaticFilterResponse{Status: st})
+}
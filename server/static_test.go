@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	metro "github.com/dgryski/go-metro"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func buildStaticFilter(c pb.CuckooFilterClient, req *pb.BuildStaticFilterRequest, batches ...[]string) (*pb.BuildStaticFilterResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := c.BuildStaticFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, elements := range batches {
+		if i > 0 {
+			req = &pb.BuildStaticFilterRequest{}
+		}
+		req.Elements = elements
+		if err := stream.Send(req); err != nil {
+			break
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func TestXorFilter(t *testing.T) {
+	for _, n := range []int{0, 1, 10, 100000} {
+		for _, bits := range staticFingerprintSizes {
+			hashes := make([]uint64, 0, n+1)
+			for i := 0; i < n; i++ {
+				hashes = append(hashes, metro.Hash64([]byte(strconv.Itoa(i)), hashSeed))
+			}
+			if n > 0 {
+				hashes = append(hashes, hashes[0])
+			}
+			xf, err := buildXorFilter(hashes, bits)
+			if !assert.NoError(t, err, n) {
+				continue
+			}
+			assert.Equal(t, uint(n), xf.Count())
+			for i := 0; i < n; i++ {
+				assert.True(t, xf.Lookup([]byte(strconv.Itoa(i))), i)
+			}
+			if n < 100000 {
+				continue
+			}
+			hits := 0
+			for i := n; i < 11*n; i++ {
+				if xf.Lookup([]byte(strconv.Itoa(i))) {
+					hits++
+				}
+			}
+			assert.InEpsilon(t, math.Ldexp(1, -int(bits)), float64(hits)/float64(10*n), 0.25, bits)
+			assert.InDelta(t, 1.23*float64(bits), float64(8*len(xf.table))/float64(n), 0.1, bits)
+		}
+	}
+}
+
+func TestBuildStaticFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	c := newTestClient(t, s)
+	res, err := buildStaticFilter(c, &pb.BuildStaticFilterRequest{FilterName: "aaa"}, []string{"jack", "mary"}, []string{"rose", "jack"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), res.Count)
+	_, err = buildStaticFilter(c, &pb.BuildStaticFilterRequest{FilterName: "bbb", FingerprintBits: 8}, []string{"lucy"})
+	assert.NoError(t, err)
+	assert.NoError(t, s.Dump(dir))
+	_, err = buildStaticFilter(c, &pb.BuildStaticFilterRequest{FilterName: "bbb", FingerprintBits: 8, Replace: true}, []string{"tom"})
+	assert.NoError(t, err)
+
+	// Static filters survive a snapshot and a replay of the log.
+	s = restart(t, s, dir)
+	for _, e := range []string{"jack", "mary", "rose"} {
+		assert.True(t, found(s, "aaa", e), e)
+	}
+	assert.True(t, found(s, "bbb", "tom"))
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(3), count.Len)
+	info, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "bbb"})
+	assert.Equal(t, "xor", info.Info.Backend)
+	assert.Equal(t, uint32(8), info.Info.FingerprintBits)
+	lookup, _ := s.LookupElements(ctx, &pb.LookupElementsRequest{FilterName: "aaa", Elements: []string{"jack", "tom"}})
+	assert.Equal(t, []string{"jack"}, lookup.MatchedElements)
+}
+
+func TestStaticFilterUnsupported(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := buildStaticFilter(newTestClient(t, s), &pb.BuildStaticFilterRequest{FilterName: "aaa"}, []string{"jack"})
+	assert.NoError(t, err)
+
+	// Static filters cannot be changed.
+	for _, err := range []error{
+		func() error {
+			_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "tom"})
+			return err
+		}(),
+		func() error {
+			_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"tom"}})
+			return err
+		}(),
+		func() error {
+			_, err := s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+			return err
+		}(),
+		func() error {
+			_, err := s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "aaa"})
+			return err
+		}(),
+	} {
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	}
+	assert.True(t, found(s, "aaa", "jack"))
+}
+
+func TestBuildStaticFilterFailures(t *testing.T) {
+	s := NewServer()
+	c := newTestClient(t, s)
+	_, err := buildStaticFilter(c, &pb.BuildStaticFilterRequest{FilterName: "aaa"}, []string{"jack"})
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		req    *pb.BuildStaticFilterRequest
+		reason string
+	}{
+		{&pb.BuildStaticFilterRequest{FilterName: "aaa"}, "FILTER_ALREADY_EXISTS"},
+		{&pb.BuildStaticFilterRequest{FilterName: ""}, "INVALID_FILTER_NAME"},
+		{&pb.BuildStaticFilterRequest{FilterName: "ccc", FingerprintBits: 12}, "INVALID_FILTER_CONFIG"},
+		{&pb.BuildStaticFilterRequest{FilterName: "ccc", Elements: make([]string, maxElementCount+1)}, "TOO_MANY_ELEMENTS"},
+	} {
+		_, err := buildStaticFilter(c, tc.req, tc.req.Elements)
+		assert.Equal(t, tc.reason, errorReason(err), tc.req.FilterName)
+	}
+}
+
+func TestCreateXorFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Xor filters are only built from a stream of elements.
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 10, Backend: "xor"})
+	assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err))
+}
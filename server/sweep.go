@@ -0,0 +1,70 @@
+package server
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"time"
+)
+
+// expiryOf returns the expiry of elements inserted now with ttl, or zero when
+// ttl is unset. It returns the violated field when ttl is invalid.
+func expiryOf(ttl *durationpb.Duration) (uint32, *errdetails.BadRequest) {
+	if ttl == nil {
+		return 0, nil
+	}
+	if err := ttl.CheckValid(); err != nil {
+		return 0, invalidArgument("ttl", err)
+	}
+	d := ttl.AsDuration()
+	if d <= 0 {
+		return 0, badRequest("ttl", "ttl must be positive")
+	}
+	if d/expiryTick >= math.MaxUint32-time.Duration(expiryNow())-1 {
+		return 0, badRequest("ttl", "ttl is too long")
+	}
+	return expiryAt(timeNow().Add(d)), nil
+}
+
+// sweep removes the elements that expired from every filter. Expired
+// elements are already absent to lookups, so sweeps are not logged: a replay
+// brings them back only until the next sweep.
+func (s *cuckooFilterServer) sweep() {
+	for _, f := range s.filters.all() {
+		f.mu.Lock()
+		swept := f.cf.Sweep(expiryNow())
+		f.mu.Unlock()
+		f.stats.expired(swept)
+	}
+}
+
+// StartSweeps removes expired elements every interval until StopSweeps is
+// called.
+func (s *cuckooFilterServer) StartSweeps(interval time.Duration) {
+	s.sweepWait = make(chan struct{})
+	s.sweepDone = make(chan struct{})
+	go func() {
+		defer close(s.sweepDone)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sweep()
+			case <-s.sweepWait:
+				return
+			}
+		}
+	}()
+}
+
+// StopSweeps stops the sweeps started by StartSweeps and waits for one in
+// progress to finish.
+func (s *cuckooFilterServer) StopSweeps() {
+	if s.sweepWait == nil {
+		return
+	}
+	close(s.sweepWait)
+	<-s.sweepDone
+	s.sweepWait = nil
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCuckooFilterExpiry(t *testing.T) {
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	// A full filter relocates fingerprints, which keep their expiry.
+	f := newCuckooFilter(64, 16, 4)
+	base := expiryNow()
+	n := 0
+	for ; f.InsertUntil([]byte(strconv.Itoa(n)), base+1+uint32(n%4)); n++ {
+	}
+	assert.Greater(t, n, 200)
+	for i := 0; i < n; i++ {
+		assert.True(t, f.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+
+	now = now.Add(2 * expiryTick)
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%4 >= 2, f.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Equal(t, uint(n), f.Count())
+	swept := f.Sweep(expiryNow())
+	assert.Equal(t, uint(n-swept), f.Count())
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%4 >= 2, f.Lookup([]byte(strconv.Itoa(i))), i)
+	}
+	assert.Zero(t, f.Sweep(expiryNow()))
+}
+
+func TestInsertElementTTL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Date(2021, 1, 2, 3, 0, 30, 0, time.UTC)
+	fakeClock(t, &now)
+
+	s, dir := loggedServer(t)
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack", Ttl: durationpb.New(15 * time.Minute)})
+	assert.NoError(t, err)
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+	assert.NoError(t, s.Dump(dir))
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"rose", "lily"}, Ttl: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+
+	// Expiries survive a snapshot and a replay of the log.
+	s = restart(t, s, dir)
+
+	// Expiries are rounded up, so elements never expire early.
+	now = now.Add(15 * time.Minute)
+	assert.True(t, found(s, "aaa", "jack"))
+	now = now.Add(time.Minute)
+	assert.False(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "mary"))
+	assert.True(t, found(s, "aaa", "rose"))
+	res, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(4), res.Len)
+
+	s.sweep()
+	res, _ = s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(3), res.Len)
+
+	now = now.Add(time.Hour)
+	s.StartSweeps(time.Millisecond)
+	assert.Eventually(t, func() bool {
+		info, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+		return info.Info.Stats.Expired == 3
+	}, 5*time.Second, time.Millisecond)
+	s.StopSweeps()
+	assert.False(t, found(s, "aaa", "rose"))
+	assert.True(t, found(s, "aaa", "mary"))
+	res, _ = s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(1), res.Len)
+}
+
+func TestDeleteElementExpiredTTL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)
+	fakeClock(t, &now)
+
+	s, dir := loggedServer(t)
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	ttl := durationpb.New(time.Minute)
+	s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary", "rose"}, Ttl: ttl})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+	s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "rose"})
+	now = now.Add(2 * time.Minute)
+
+	_, err := s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.Equal(t, "ELEMENT_NOT_FOUND", errorReason(err))
+	res, err := s.DeleteElements(ctx, &pb.DeleteElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.DeleteResult{pb.DeleteResult_NOT_FOUND, pb.DeleteResult_DELETED}, res.Results)
+
+	// The copy of mary without a ttl was deleted; the expired ones are left
+	// to the sweep.
+	assert.False(t, found(s, "aaa", "mary"))
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(2), count.Len)
+
+	// A logged delete of an element that has expired since still replays.
+	s.CloseWAL()
+	s = NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Zero(t, report.ReplayFailed)
+	assert.False(t, found(s, "aaa", "mary"))
+}
+
+func TestInsertElementInvalidTTL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	for _, ttl := range []*durationpb.Duration{{Seconds: -1}, {Seconds: 0}, {Seconds: 1, Nanos: -1}} {
+		_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack", Ttl: ttl})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), ttl.String())
+		assert.Equal(t, "INVALID_TTL", errorReason(err), ttl.String())
+
+		_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack"}, Ttl: ttl})
+		assert.Equal(t, "INVALID_TTL", errorReason(err), ttl.String())
+	}
+	assert.False(t, found(s, "aaa", "jack"))
+}
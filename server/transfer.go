@@ -0,0 +1,150 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"io"
+	"strings"
+)
+
+const (
+	// exportChunkSize keeps every ExportFilter response well below the
+	// default 4MB gRPC message limit.
+	exportChunkSize = 1 << 20
+	// maxImportSize bounds the serialized filter ImportFilter accepts, which
+	// it has to hold in memory and log as a single write-ahead log record.
+	maxImportSize = 1 << 30
+)
+
+// ExportFilter streams a filter serialized in the snapshot file format. The
+// filter is captured under its lock, so the export is consistent even while
+// it is being written to.
+func (s *cuckooFilterServer) ExportFilter(req *pb.ExportFilterRequest, stream pb.CuckooFilter_ExportFilterServer) error {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		if err := s.fail(StatusNoFilterFound, req.FilterName); err != nil {
+			return err
+		}
+		return stream.Send(&pb.ExportFilterResponse{Status: StatusNoFilterFound})
+	}
+
+	h, payload := filter.snapshot(req.FilterName)
+	b, err := encodeSnapshot(h, payload, s.compression)
+	if err != nil {
+		return err
+	}
+	res := &pb.ExportFilterResponse{Status: StatusOK, Size: uint64(len(b)), Sha256: checksum(b)}
+	for {
+		n := len(b)
+		if n > exportChunkSize {
+			n = exportChunkSize
+		}
+		res.Chunk, b = b[:n], b[n:]
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			return nil
+		}
+		res = &pb.ExportFilterResponse{Status: StatusOK}
+	}
+}
+
+// ImportFilter builds a filter from the chunks of an ExportFilter stream and
+// registers it once the whole serialization has arrived and verified.
+func (s *cuckooFilterServer) ImportFilter(stream pb.CuckooFilter_ImportFilterServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return s.importFailed(stream, StatusInvalidFilterData, "", invalidArgument("chunk", errors.New("no filter data received")))
+	}
+	if err != nil {
+		return err
+	}
+	name := first.FilterName
+	if err := validateFilterName(name); err != nil {
+		return s.importFailed(stream, StatusInvalidFilterName, name, invalidArgument("filter_name", err))
+	}
+	if first.Size > maxImportSize {
+		return s.importFailed(stream, StatusInvalidFilterData, name, invalidArgument("size", fmt.Errorf("filter data must be at most %d bytes", maxImportSize)))
+	}
+	if _, ok := s.filters.get(name); ok && !first.Replace {
+		return s.importFailed(stream, StatusFilterAlreadyExist, name)
+	}
+
+	var b []byte
+	for req := first; ; {
+		b = append(b, req.Chunk...)
+		if uint64(len(b)) > first.Size {
+			return s.importFailed(stream, StatusInvalidFilterData, name, invalidArgument("size", fmt.Errorf("received more than %d bytes", first.Size)))
+		}
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if uint64(len(b)) != first.Size {
+		return s.importFailed(stream, StatusInvalidFilterData, name, invalidArgument("size", fmt.Errorf("received %d bytes, want %d", len(b), first.Size)))
+	}
+	if sum := checksum(b); !strings.EqualFold(sum, first.Sha256) {
+		return s.importFailed(stream, StatusInvalidFilterData, name, invalidArgument("sha256", fmt.Errorf("sha256 is %s, want %s", sum, first.Sha256)))
+	}
+	filter, err := decodeFilter(b)
+	if err != nil {
+		return s.importFailed(stream, StatusInvalidFilterData, name, invalidArgument("chunk", err))
+	}
+
+	st, err := s.install(name, filter, b, first.Replace)
+	if err != nil {
+		return s.importFailed(stream, st, name, logFailure(err))
+	}
+	if st != StatusOK {
+		return s.importFailed(stream, st, name)
+	}
+	return stream.SendAndClose(&pb.ImportFilterResponse{Status: StatusOK})
+}
+
+// install registers filter under name, replacing the filter there only if
+// replace is set, and logs b, its snapshot file, for replay. It returns
+// StatusLogFailed with the error when logging fails.
+func (s *cuckooFilterServer) install(name string, filter *filter, b []byte, replace bool) (*pb.Status, error) {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+	old, exists := s.filters.get(name)
+	if exists && !replace {
+		return StatusFilterAlreadyExist, nil
+	}
+	err := s.update(filter, func() *walRecord {
+		if exists {
+			old.mu.Lock()
+			old.deleted = true
+			old.mu.Unlock()
+		}
+		s.filters.set(name, filter)
+		filter.undo.record(func() {
+			if !exists {
+				s.filters.remove(name, filter)
+				return
+			}
+			old.mu.Lock()
+			old.deleted = false
+			old.mu.Unlock()
+			s.filters.set(name, old)
+		})
+		return &walRecord{op: opImportFilter, name: name, data: b}
+	})
+	if err != nil {
+		return StatusLogFailed, err
+	}
+	return StatusOK, nil
+}
+
+func (s *cuckooFilterServer) importFailed(stream pb.CuckooFilter_ImportFilterServer, st *pb.Status, filterName string, details ...proto.Message) error {
+	if err := s.fail(st, filterName, details...); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.ImportFilterResponse{Status: st})
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"testing"
+	"time"
+)
+
+func export(t *testing.T, c pb.CuckooFilterClient, filterName string) (*pb.ExportFilterResponse, [][]byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := c.ExportFilter(ctx, &pb.ExportFilterRequest{FilterName: filterName})
+	assert.NoError(t, err)
+	var first *pb.ExportFilterResponse
+	var chunks [][]byte
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return first, chunks
+		}
+		if !assert.NoError(t, err) {
+			return nil, nil
+		}
+		if first == nil {
+			first = res
+		}
+		chunks = append(chunks, res.Chunk)
+	}
+}
+
+func importFilter(c pb.CuckooFilterClient, req *pb.ImportFilterRequest, chunks [][]byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := c.ImportFilter(ctx)
+	if err != nil {
+		return err
+	}
+	for i, chunk := range chunks {
+		if i > 0 {
+			req = &pb.ImportFilterRequest{}
+		}
+		req.Chunk = chunk
+		if err := stream.Send(req); err != nil {
+			break
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+func TestExportImportFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	src := NewServer()
+	src.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 2000000})
+	src.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"jack", "mary", "rose"}})
+	first, chunks := export(t, newTestClient(t, src), "aaa")
+	assert.Greater(t, len(chunks), 1)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, len(chunk), exportChunkSize)
+	}
+
+	dst := NewServer()
+	c := newTestClient(t, dst)
+	req := &pb.ImportFilterRequest{FilterName: "bbb", Size: first.Size, Sha256: first.Sha256}
+	assert.NoError(t, importFilter(c, req, chunks))
+	for _, e := range []string{"jack", "mary", "rose"} {
+		assert.True(t, found(dst, "bbb", e), e)
+	}
+	res, _ := dst.CountElements(ctx, &pb.CountElementsRequest{FilterName: "bbb"})
+	assert.Equal(t, uint64(3), res.Len)
+
+	req = &pb.ImportFilterRequest{FilterName: "bbb", Size: first.Size, Sha256: first.Sha256}
+	err := importFilter(c, req, chunks)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	dst.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "bbb"})
+	req = &pb.ImportFilterRequest{FilterName: "bbb", Size: first.Size, Sha256: first.Sha256, Replace: true}
+	assert.NoError(t, importFilter(c, req, chunks))
+	assert.True(t, found(dst, "bbb", "jack"))
+}
+
+func TestImportFilterVerifies(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	c := newTestClient(t, s)
+	first, chunks := export(t, c, "aaa")
+
+	for _, req := range []*pb.ImportFilterRequest{
+		{FilterName: "bbb", Size: first.Size, Sha256: "00"},
+		{FilterName: "bbb", Size: first.Size + 1, Sha256: first.Sha256},
+		{FilterName: "bbb", Size: first.Size - 1, Sha256: first.Sha256},
+		{FilterName: "bbb", Size: maxImportSize + 1, Sha256: first.Sha256},
+	} {
+		err := importFilter(c, req, chunks)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "INVALID_FILTER_DATA", errorReason(err))
+	}
+
+	corrupt := append([]byte(nil), chunks[0]...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	req := &pb.ImportFilterRequest{FilterName: "bbb", Size: first.Size, Sha256: checksum(corrupt)}
+	err := importFilter(c, req, [][]byte{corrupt})
+	assert.Equal(t, "INVALID_FILTER_DATA", errorReason(err))
+
+	err = importFilter(c, &pb.ImportFilterRequest{FilterName: "", Size: first.Size, Sha256: first.Sha256}, chunks)
+	assert.Equal(t, "INVALID_FILTER_NAME", errorReason(err))
+	assert.ElementsMatch(t, []string{"aaa"}, s.filters.names())
+
+	stream, err := c.ExportFilter(ctx, &pb.ExportFilterRequest{FilterName: "ccc"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestImportFilterLogged(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	src := NewServer()
+	src.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	src.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	first, chunks := export(t, newTestClient(t, src), "aaa")
+
+	s, dir := loggedServer(t)
+	req := &pb.ImportFilterRequest{FilterName: "aaa", Size: first.Size, Sha256: first.Sha256}
+	assert.NoError(t, importFilter(newTestClient(t, s), req, chunks))
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+
+	s = restart(t, s, dir)
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "mary"))
+}
+
+// importCrafted imports a snapshot of f whose header claims config instead of
+// the config of f, as a client could build one.
+func importCrafted(t *testing.T, c pb.CuckooFilterClient, f *filter, config filterConfig) error {
+	h, payload := f.snapshot("bbb")
+	h.Config = config
+	b, err := encodeSnapshot(h, payload, CompressionNone)
+	assert.NoError(t, err)
+	return importFilter(c, &pb.ImportFilterRequest{FilterName: "bbb", Size: uint64(len(b)), Sha256: checksum(b)}, [][]byte{b})
+}
+
+func TestImportFilterRejectsLayout(t *testing.T) {
+	s := NewServer()
+	c := newTestClient(t, s)
+	for _, config := range []filterConfig{
+		{Capacity: 100, FingerprintBits: 1, BucketSize: 1},
+		{Capacity: 100, FingerprintBits: 64},
+		{Capacity: 100, BucketSize: 3},
+		{Capacity: 100, Growth: 1 << 62},
+		{Capacity: 100, ValueBits: 17},
+		{Capacity: 1 << 62},
+	} {
+		err := importCrafted(t, c, createFilter(filterConfig{Capacity: 100}), config)
+		assert.Equal(t, "INVALID_FILTER_DATA", errorReason(err), "%+v", config)
+	}
+	assert.Empty(t, s.filters.names())
+}
+
+func TestImportFilterRejectsWindow(t *testing.T) {
+	s := NewServer()
+	c := newTestClient(t, s)
+	f := createFilter(filterConfig{Capacity: 100, Window: time.Hour, Slices: 8})
+	for slice := int64(1); slice <= 4; slice++ {
+		f.cf.(*chain).advance(slice)
+	}
+	for _, config := range []filterConfig{
+		{Capacity: 100, Window: time.Hour},
+		{Capacity: 100, Window: time.Second, Slices: 8},
+		{Capacity: 100, Window: time.Hour, Slices: 2},
+	} {
+		err := importCrafted(t, c, f, config)
+		assert.Equal(t, "INVALID_FILTER_DATA", errorReason(err), "%+v", config)
+	}
+	assert.Empty(t, s.filters.names())
+}
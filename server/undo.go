@@ -0,0 +1,39 @@
+package server
+
+// undoLog records how to take back the mutations update applies to a
+// filter, so that a mutation the write-ahead log failed to record can be
+// rolled back. Recording into a nil undoLog does nothing.
+type undoLog struct {
+	steps []func()
+	// detach stops each structure recording into the log.
+	detach []func()
+}
+
+// record adds a step restoring the state before a mutation.
+func (u *undoLog) record(step func()) {
+	if u != nil {
+		u.steps = append(u.steps, step)
+	}
+}
+
+// onStop calls detach when the recording stops.
+func (u *undoLog) onStop(detach func()) {
+	u.detach = append(u.detach, detach)
+}
+
+// stop ends the recording.
+func (u *undoLog) stop() {
+	for _, detach := range u.detach {
+		detach()
+	}
+	u.detach = nil
+}
+
+// undo takes back the recorded steps, newest first. The recording must have
+// stopped.
+func (u *undoLog) undo() {
+	for i := len(u.steps) - 1; i >= 0; i-- {
+		u.steps[i]()
+	}
+	u.steps = nil
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+)
+
+// insertUnique inserts element into f unless f already holds it. The caller
+// holds f.mu.
+func (f *filter) insertUnique(element []byte, value uint32, expiry uint32) pb.InsertResult {
+	switch {
+	case f.cf.Lookup(element):
+		return pb.InsertResult_ALREADY_PRESENT
+	case f.insert(element, value, expiry):
+		return pb.InsertResult_ADDED
+	}
+	return pb.InsertResult_INSERT_FAILED
+}
+
+func (s *cuckooFilterServer) InsertUnique(ctx context.Context, req *pb.InsertUniqueRequest) (*pb.InsertUniqueResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.InsertUniqueResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if !filter.cf.supports(canInsert) {
+		return &pb.InsertUniqueResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canInsert))
+	}
+	expiry, bad := expiryOf(req.Ttl)
+	if bad != nil {
+		return &pb.InsertUniqueResponse{Status: StatusInvalidTTL}, s.fail(StatusInvalidTTL, req.FilterName, bad)
+	}
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return &pb.InsertUniqueResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canExpire))
+	}
+	if st, detail := checkValues(filter, "value", req.Value); st != nil {
+		return &pb.InsertUniqueResponse{Status: st}, s.fail(st, req.FilterName, detail)
+	}
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.InsertUniqueResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
+	}
+	var result pb.InsertResult
+	err := s.update(filter, func() *walRecord {
+		switch result = filter.insertUnique(element, req.Value, expiry); result {
+		case pb.InsertResult_ADDED:
+			filter.stats.inserted(1, 0)
+			return &walRecord{op: opInsertElements, name: req.FilterName, elements: [][]byte{element}, expiry: expiry, values: filter.logValues(req.Value)}
+		case pb.InsertResult_INSERT_FAILED:
+			filter.stats.inserted(0, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return &pb.InsertUniqueResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	if result == pb.InsertResult_INSERT_FAILED {
+		return &pb.InsertUniqueResponse{Status: StatusInsertionFailed, Result: result}, s.fail(StatusInsertionFailed, req.FilterName)
+	}
+	return &pb.InsertUniqueResponse{Status: StatusOK, Result: result}, nil
+}
+
+func (s *cuckooFilterServer) InsertUniqueBatch(ctx context.Context, req *pb.InsertUniqueBatchRequest) (*pb.InsertUniqueBatchResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.InsertUniqueBatchResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	elements := elementSet{req.Elements, req.RawElements}
+	if elements.len() > maxElementCount {
+		return &pb.InsertUniqueBatchResponse{Status: StatusOverLimitation}, s.fail(StatusOverLimitation, req.FilterName, overLimitation("elements"))
+	}
+	if !filter.cf.supports(canInsert) {
+		return &pb.InsertUniqueBatchResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canInsert))
+	}
+	expiry, bad := expiryOf(req.Ttl)
+	if bad != nil {
+		return &pb.InsertUniqueBatchResponse{Status: StatusInvalidTTL}, s.fail(StatusInvalidTTL, req.FilterName, bad)
+	}
+	if expiry != 0 && !filter.cf.supports(canExpire) {
+		return &pb.InsertUniqueBatchResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canExpire))
+	}
+	if len(req.Values) != 0 && len(req.Values) != elements.len() {
+		return &pb.InsertUniqueBatchResponse{Status: StatusInvalidValue}, s.fail(StatusInvalidValue, req.FilterName, badRequest("values", "values must be empty or one per element"))
+	}
+	if st, detail := checkValues(filter, "values", req.Values...); st != nil {
+		return &pb.InsertUniqueBatchResponse{Status: st}, s.fail(st, req.FilterName, detail)
+	}
+	results := make([]pb.InsertResult, elements.len())
+	err := s.update(filter, func() *walRecord {
+		inserted := make([][]byte, 0, len(results))
+		values := make([]uint32, 0, len(results))
+		failed := 0
+		for i := range results {
+			var value uint32
+			if len(req.Values) != 0 {
+				value = req.Values[i]
+			}
+			element := elements.at(i)
+			switch results[i] = filter.insertUnique(element, value, expiry); results[i] {
+			case pb.InsertResult_ADDED:
+				inserted = append(inserted, element)
+				values = append(values, value)
+			case pb.InsertResult_INSERT_FAILED:
+				failed++
+			}
+		}
+		filter.stats.inserted(len(inserted), failed)
+		if len(inserted) == 0 {
+			return nil
+		}
+		return &walRecord{op: opInsertElements, name: req.FilterName, elements: inserted, expiry: expiry, values: filter.logValues(values...)}
+	})
+	if err != nil {
+		return &pb.InsertUniqueBatchResponse{Status: StatusLogFailed}, s.fail(StatusLogFailed, req.FilterName, logFailure(err))
+	}
+	return &pb.InsertUniqueBatchResponse{Status: StatusOK, Results: results}, nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestInsertUnique(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	res, err := s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.InsertResult_ADDED, res.Result)
+	res, err = s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.InsertResult_ALREADY_PRESENT, res.Result)
+
+	batch, err := s.InsertUniqueBatch(ctx, &pb.InsertUniqueBatchRequest{FilterName: "aaa", Elements: []string{"mary", "jack", "rose", "mary"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.InsertResult{pb.InsertResult_ADDED, pb.InsertResult_ALREADY_PRESENT, pb.InsertResult_ADDED, pb.InsertResult_ALREADY_PRESENT}, batch.Results)
+
+	// Elements are added once, so a single delete removes them.
+	s = restart(t, s, dir)
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(3), count.Len)
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, err)
+	assert.False(t, found(s, "aaa", "jack"))
+}
+
+func TestInsertUniqueConcurrent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+
+	// Concurrent inserts of an element add it once.
+	var added int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", Element: "lucy"})
+			if assert.NoError(t, err) && res.Result == pb.InsertResult_ADDED {
+				mu.Lock()
+				added++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, added)
+	occurrences, _ := s.CountElement(ctx, &pb.CountElementRequest{FilterName: "aaa", Element: "lucy"})
+	assert.Equal(t, uint64(1), occurrences.Count)
+}
+
+func TestInsertUniqueFull(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Elements that do not fit are reported without failing the batch.
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 4})
+	assert.NoError(t, err)
+	elements := make([]string, 100)
+	for i := range elements {
+		elements[i] = strconv.Itoa(i)
+	}
+	batch, err := s.InsertUniqueBatch(ctx, &pb.InsertUniqueBatchRequest{FilterName: "bbb", Elements: elements})
+	assert.NoError(t, err)
+	assert.Len(t, batch.Results, len(elements))
+	assert.Contains(t, batch.Results, pb.InsertResult_INSERT_FAILED)
+	assert.NotContains(t, batch.Results, pb.InsertResult_INSERT_RESULT_UNSPECIFIED)
+	for i, r := range batch.Results {
+		if r == pb.InsertResult_ADDED {
+			assert.True(t, found(s, "bbb", elements[i]), i)
+		}
+	}
+	res, err := s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "bbb", Element: "tom"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, pb.InsertResult_INSERT_FAILED, res.Result)
+}
+
+func TestInsertUniqueFailures(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000})
+	assert.NoError(t, err)
+	_, err = s.InsertUniqueBatch(ctx, &pb.InsertUniqueBatchRequest{FilterName: "aaa", Elements: make([]string, maxElementCount+1)})
+	assert.Equal(t, "TOO_MANY_ELEMENTS", errorReason(err))
+	_, err = s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", Element: "tom", Value: 1})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "ccc", Element: "tom"})
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+}
+
+func TestInsertUniqueValues(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100, ValueBits: 8})
+	assert.NoError(t, err)
+	_, err = s.InsertUniqueBatch(ctx, &pb.InsertUniqueBatchRequest{FilterName: "aaa", Elements: []string{"jack", "mary"}, Values: []uint32{1, 2}})
+	assert.NoError(t, err)
+	// Present elements keep their value.
+	res, err := s.InsertUnique(ctx, &pb.InsertUniqueRequest{FilterName: "aaa", Element: "jack", Value: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.InsertResult_ALREADY_PRESENT, res.Result)
+	value, _ := s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "aaa", Element: "jack"})
+	assert.Equal(t, uint32(1), value.Value)
+
+	_, err = s.InsertUniqueBatch(ctx, &pb.InsertUniqueBatchRequest{FilterName: "aaa", Elements: []string{"rose"}, Values: []uint32{1, 2}})
+	assert.Equal(t, "INVALID_VALUE", errorReason(err))
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+)
+
+// maxValueBits bounds the values of value filters, which are read as 16 bit
+// numbers.
+const maxValueBits = 16
+
+// checkValues checks the values of an insert into f: zero, unless f stores
+// values of that size. It returns the status and details of a failure.
+func checkValues(f *filter, field string, values ...uint32) (*pb.Status, proto.Message) {
+	for _, v := range values {
+		switch {
+		case v == 0:
+		case f.config.ValueBits == 0:
+			return StatusUnsupported, unsupported(f, canStoreValues)
+		case v >= 1<<f.config.ValueBits:
+			return StatusInvalidValue, badRequest(field, fmt.Sprintf("values must be below %d", 1<<f.config.ValueBits))
+		}
+	}
+	return nil, nil
+}
+
+// insert inserts element into f, with value if f stores values.
+func (f *filter) insert(element []byte, value uint32, expiry uint32) bool {
+	if f.config.ValueBits != 0 {
+		return f.cf.InsertValue(element, uint16(value), expiry)
+	}
+	return f.cf.InsertUntil(element, expiry)
+}
+
+// logValues returns the values of the inserted elements to log, or nil if
+// f stores none.
+func (f *filter) logValues(values ...uint32) []uint16 {
+	if f.config.ValueBits == 0 {
+		return nil
+	}
+	logged := make([]uint16, len(values))
+	for i, v := range values {
+		logged[i] = uint16(v)
+	}
+	return logged
+}
+
+func (s *cuckooFilterServer) GetElementValue(ctx context.Context, req *pb.GetElementValueRequest) (*pb.GetElementValueResponse, error) {
+	filter, ok := s.filters.get(req.FilterName)
+	if !ok {
+		return &pb.GetElementValueResponse{Status: StatusNoFilterFound}, s.fail(StatusNoFilterFound, req.FilterName)
+	}
+	if !filter.cf.supports(canStoreValues) {
+		return &pb.GetElementValueResponse{Status: StatusUnsupported}, s.fail(StatusUnsupported, req.FilterName, unsupported(filter, canStoreValues))
+	}
+	element, invalid := elementOf(req.Element, req.RawElement)
+	if invalid != nil {
+		return &pb.GetElementValueResponse{Status: StatusInvalidElement}, s.fail(StatusInvalidElement, req.FilterName, invalid)
+	}
+	filter.mu.RLock()
+	value, found := filter.cf.Value(element)
+	filter.mu.RUnlock()
+	hits := 0
+	if found {
+		hits = 1
+	}
+	filter.stats.lookedUp(1, hits)
+	return &pb.GetElementValueResponse{Status: StatusOK, Found: found, Value: uint32(value)}, nil
+}
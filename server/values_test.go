@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestChainValues(t *testing.T) {
+	config := filterConfig{Capacity: 500, Growth: 2, ValueBits: 16}
+	c := newChain(config)
+	for i := 0; i < 2000; i++ {
+		assert.True(t, c.InsertValue([]byte(strconv.Itoa(i)), uint16(i), 0), i)
+	}
+	assert.Greater(t, len(c.filters), 1)
+	for i := 0; i < 2000; i += 2 {
+		assert.True(t, c.InsertValue([]byte(strconv.Itoa(i)), uint16(i+1), 0), i)
+	}
+	// Reinserting replaces the value instead of adding an element.
+	assert.Equal(t, uint(2000), c.Count())
+	for i := 0; i < 2000; i++ {
+		value, ok := c.Value([]byte(strconv.Itoa(i)))
+		assert.True(t, ok, i)
+		assert.Equal(t, uint16(i+1-i%2), value, i)
+	}
+
+	decoded, err := decodeChain(c.Encode(), c.buckets(), nil, nil, config)
+	assert.NoError(t, err)
+	assert.Equal(t, c.Encode(), decoded.Encode())
+	value, ok := decoded.Value([]byte("42"))
+	assert.True(t, ok)
+	assert.Equal(t, uint16(43), value)
+}
+
+func TestChainValuesDelete(t *testing.T) {
+	c := newChain(filterConfig{Capacity: 100, ValueBits: 16})
+	for i := 0; i < 50; i++ {
+		assert.True(t, c.InsertValue([]byte(strconv.Itoa(i)), uint16(i), 0), i)
+	}
+	assert.True(t, c.Delete([]byte("42")))
+	_, ok := c.Value([]byte("42"))
+	assert.False(t, ok)
+	c.Reset()
+	_, ok = c.Value([]byte("0"))
+	assert.False(t, ok)
+}
+
+func TestChainValueBits(t *testing.T) {
+	for _, bits := range []uint{1, 3, 8, 13, 16} {
+		config := filterConfig{Capacity: 1000, ValueBits: bits}
+		c := newChain(config)
+		max := uint16(1<<bits - 1)
+		for i := 0; i < 900; i++ {
+			assert.True(t, c.InsertValue([]byte(strconv.Itoa(i)), uint16(i*7)&max, 0), bits)
+		}
+		// Values take their bits in each slot, without overwriting their
+		// neighbours.
+		slots := c.filters[0].buckets * uint64(c.filters[0].bucketSize)
+		assert.Equal(t, int(slots)*int(bits)/8, c.filters[0].valuesSize(), bits)
+		decoded, err := decodeChain(c.Encode(), c.buckets(), nil, nil, config)
+		assert.NoError(t, err)
+		for i := 0; i < 900; i++ {
+			value, ok := decoded.Value([]byte(strconv.Itoa(i)))
+			assert.True(t, ok, bits)
+			assert.Equal(t, uint16(i*7)&max, value, bits)
+		}
+	}
+}
+
+func TestGetElementValue(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000, ValueBits: 8})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack", Value: 1})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"mary", "rose"}, Values: []uint32{2, 3}})
+	assert.NoError(t, err)
+	assert.NoError(t, s.Dump(dir))
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack", Value: 255})
+	assert.NoError(t, err)
+	_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"lucy"}})
+	assert.NoError(t, err)
+
+	// Values survive a snapshot and a replay of the log.
+	s = restart(t, s, dir)
+	for e, want := range map[string]uint32{"jack": 255, "mary": 2, "rose": 3, "lucy": 0} {
+		res, err := s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "aaa", Element: e})
+		assert.NoError(t, err)
+		assert.True(t, res.Found, e)
+		assert.Equal(t, want, res.Value, e)
+	}
+	res, err := s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "aaa", Element: "tom"})
+	assert.NoError(t, err)
+	assert.False(t, res.Found)
+	count, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(4), count.Len)
+	info, _ := s.GetFilterInfo(ctx, &pb.GetFilterInfoRequest{FilterName: "aaa"})
+	assert.Equal(t, uint32(8), info.Info.ValueBits)
+}
+
+func TestInsertInvalidValue(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 1000, ValueBits: 8})
+	assert.NoError(t, err)
+	for _, err := range []error{
+		func() error {
+			_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "tom", Value: 256})
+			return err
+		}(),
+		func() error {
+			_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"tom", "lily"}, Values: []uint32{1}})
+			return err
+		}(),
+		func() error {
+			_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"tom", "lily"}, Values: []uint32{1, 256}})
+			return err
+		}(),
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "INVALID_VALUE", errorReason(err))
+	}
+	assert.False(t, found(s, "aaa", "tom"))
+	assert.False(t, found(s, "aaa", "lily"))
+}
+
+func TestValuesUnsupported(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Filters without values reject them.
+	s := NewServer()
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 10})
+	assert.NoError(t, err)
+	_, err = s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "tom", Value: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "bbb", Element: "tom"})
+	assert.Equal(t, "UNSUPPORTED_OPERATION", errorReason(err))
+	_, err = s.GetElementValue(ctx, &pb.GetElementValueRequest{FilterName: "ccc", Element: "tom"})
+	assert.Equal(t, "FILTER_NOT_FOUND", errorReason(err))
+}
+
+func TestCreateValueFilterInvalidConfig(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewServer()
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "ccc", Capacity: 10, ValueBits: 17},
+		{FilterName: "ccc", Capacity: 10, ValueBits: 8, Counting: true},
+		{FilterName: "ccc", Capacity: 10, ValueBits: 8, Window: durationpb.New(time.Hour)},
+		{FilterName: "ccc", Capacity: 10, ValueBits: 8, Backend: "bloom"},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.Equal(t, "INVALID_FILTER_CONFIG", errorReason(err), req.String())
+	}
+}
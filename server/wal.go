@@ -0,0 +1,631 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SyncPolicy controls how often the write-ahead log is fsynced.
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs after every mutation before the RPC returns.
+	SyncAlways SyncPolicy = iota
+	// SyncEverySecond fsyncs once a second, so a machine crash loses at most
+	// about a second of mutations. A crash of the server process alone loses
+	// nothing, as every record is handed to the OS before the RPC returns.
+	SyncEverySecond
+	// SyncNever leaves flushing to the OS.
+	SyncNever
+)
+
+// ParseSyncPolicy parses "always", "everysec" or "never".
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch s {
+	case "always":
+		return SyncAlways, nil
+	case "everysec":
+		return SyncEverySecond, nil
+	case "never":
+		return SyncNever, nil
+	}
+	return 0, fmt.Errorf("unknown wal sync policy %q", s)
+}
+
+const (
+	walDirName       = "wal"
+	walSegmentSuffix = ".log"
+	// maxKeptBuffer bounds the buffer the log keeps between appends, so
+	// that a large import does not pin its size for the life of the log.
+	maxKeptBuffer = 1 << 20
+)
+
+type walOp byte
+
+const (
+	opCreateFilter walOp = iota + 1
+	opDeleteFilter
+	opInsertElements
+	opDeleteElements
+	opResetFilter
+	opImportFilter
+	opInsertHashes
+)
+
+// walRecord is one logged mutation. Only mutations that changed a filter are
+// logged, but a replay need not rebuild the filter the way it was: inserts
+// move fingerprints to random slots, and sweeps are not logged, so expired
+// elements can take up slots a logged insert went to. apply reports records
+// whose replay failed where the original succeeded.
+// slice is the slice an insert into a windowed filter went to, expiry the
+// expiry of the inserted elements and values their values in a value filter.
+// Elements inserted by hash are logged as hashes.
+type walRecord struct {
+	op       walOp
+	name     string
+	config   filterConfig
+	elements [][]byte
+	hashes   []uint64
+	data     []byte
+	slice    int64
+	expiry   uint32
+	values   []uint16
+}
+
+// maxRecordSize bounds the payload of a record. It is above the largest
+// imported filter a record can carry, so only damaged headers exceed it.
+const maxRecordSize = maxImportSize + 1<<20
+
+var errCorruptRecord = errors.New("corrupt wal record")
+
+// A record is framed as its payload length and CRC-32C, both little endian
+// uint32, followed by the payload: the op, the filter name and the op's
+// arguments, with lengths and numbers as uvarints.
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+func (r *walRecord) marshal(buf []byte) []byte {
+	buf = append(buf[:0], 0, 0, 0, 0, 0, 0, 0, 0, byte(r.op))
+	buf = appendBytes(buf, []byte(r.name))
+	switch r.op {
+	case opCreateFilter:
+		// The capacity comes first for records written before the whole
+		// config was logged.
+		buf = appendUvarint(buf, r.config.Capacity)
+		config, _ := json.Marshal(r.config)
+		buf = appendBytes(buf, config)
+	case opInsertElements, opDeleteElements:
+		buf = appendUvarint(buf, uint64(len(r.elements)))
+		for _, e := range r.elements {
+			buf = appendBytes(buf, e)
+		}
+		// The slice, expiry and values follow the elements only when any
+		// of them is set.
+		if r.slice != 0 || r.expiry != 0 || r.values != nil {
+			buf = appendUvarint(buf, uint64(r.slice))
+		}
+		if r.expiry != 0 || r.values != nil {
+			buf = appendUvarint(buf, uint64(r.expiry))
+		}
+		for _, v := range r.values {
+			buf = appendUvarint(buf, uint64(v))
+		}
+	case opInsertHashes:
+		buf = appendUvarint(buf, uint64(len(r.hashes)))
+		var b [8]byte
+		for _, h := range r.hashes {
+			binary.LittleEndian.PutUint64(b[:], h)
+			buf = append(buf, b[:]...)
+		}
+		buf = appendUvarint(buf, uint64(r.slice))
+		buf = appendUvarint(buf, uint64(r.expiry))
+	case opImportFilter:
+		buf = appendBytes(buf, r.data)
+	}
+	payload := buf[8:]
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:], crc32.Checksum(payload, crcTable))
+	return buf
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	return append(buf, b[:binary.PutUvarint(b[:], x)]...)
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = appendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+func readBytes(payload []byte) ([]byte, []byte, error) {
+	n, k := binary.Uvarint(payload)
+	if k <= 0 || uint64(len(payload)-k) < n {
+		return nil, nil, errCorruptRecord
+	}
+	return payload[k : k+int(n)], payload[k+int(n):], nil
+}
+
+// readRecord reads the next record from r. It returns io.EOF at a clean end
+// of the log and errCorruptRecord for a torn or damaged record.
+func readRecord(r io.Reader) (*walRecord, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errCorruptRecord
+		}
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(header[0:])
+	if size > maxRecordSize {
+		return nil, errCorruptRecord
+	}
+	// A damaged header can claim up to maxRecordSize, so large payloads are
+	// only allocated as they are actually read.
+	var payload []byte
+	if size <= 1<<20 {
+		payload = make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return nil, errCorruptRecord
+		}
+	} else {
+		var err error
+		if payload, err = ioutil.ReadAll(io.LimitReader(r, int64(size))); err != nil || len(payload) != int(size) {
+			return nil, errCorruptRecord
+		}
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:]) || len(payload) == 0 {
+		return nil, errCorruptRecord
+	}
+
+	rec := &walRecord{op: walOp(payload[0])}
+	name, payload, err := readBytes(payload[1:])
+	if err != nil {
+		return nil, err
+	}
+	rec.name = string(name)
+	switch rec.op {
+	case opCreateFilter:
+		var k int
+		if rec.config.Capacity, k = binary.Uvarint(payload); k <= 0 {
+			return nil, errCorruptRecord
+		}
+		if payload = payload[k:]; len(payload) > 0 {
+			config, _, err := readBytes(payload)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(config, &rec.config); err != nil {
+				return nil, errCorruptRecord
+			}
+		}
+	case opInsertElements, opDeleteElements:
+		n, k := binary.Uvarint(payload)
+		if k <= 0 || n > uint64(len(payload)) {
+			return nil, errCorruptRecord
+		}
+		payload = payload[k:]
+		rec.elements = make([][]byte, n)
+		for i := range rec.elements {
+			if rec.elements[i], payload, err = readBytes(payload); err != nil {
+				return nil, err
+			}
+		}
+		if len(payload) > 0 {
+			slice, k := binary.Uvarint(payload)
+			if k <= 0 || slice > math.MaxInt64 {
+				return nil, errCorruptRecord
+			}
+			rec.slice, payload = int64(slice), payload[k:]
+		}
+		if len(payload) > 0 {
+			expiry, k := binary.Uvarint(payload)
+			if k <= 0 || expiry > math.MaxUint32 {
+				return nil, errCorruptRecord
+			}
+			rec.expiry, payload = uint32(expiry), payload[k:]
+		}
+		if len(payload) > 0 {
+			rec.values = make([]uint16, len(rec.elements))
+			for i := range rec.values {
+				v, k := binary.Uvarint(payload)
+				if k <= 0 || v > math.MaxUint16 {
+					return nil, errCorruptRecord
+				}
+				rec.values[i], payload = uint16(v), payload[k:]
+			}
+		}
+	case opInsertHashes:
+		n, k := binary.Uvarint(payload)
+		if k <= 0 || n > uint64(len(payload))/8 {
+			return nil, errCorruptRecord
+		}
+		payload = payload[k:]
+		if uint64(len(payload)) < 8*n {
+			return nil, errCorruptRecord
+		}
+		rec.hashes = make([]uint64, n)
+		for i := range rec.hashes {
+			rec.hashes[i], payload = binary.LittleEndian.Uint64(payload), payload[8:]
+		}
+		slice, k := binary.Uvarint(payload)
+		if k <= 0 || slice > math.MaxInt64 {
+			return nil, errCorruptRecord
+		}
+		payload = payload[k:]
+		expiry, k := binary.Uvarint(payload)
+		if k <= 0 || expiry > math.MaxUint32 {
+			return nil, errCorruptRecord
+		}
+		rec.slice, rec.expiry = int64(slice), uint32(expiry)
+	case opImportFilter:
+		if rec.data, _, err = readBytes(payload); err != nil {
+			return nil, err
+		}
+	case opDeleteFilter, opResetFilter:
+	default:
+		return nil, errCorruptRecord
+	}
+	return rec, nil
+}
+
+// wal is an append-only log of filter mutations, split into numbered
+// segments. Every snapshot starts a new segment; the snapshot's manifest
+// records the first segment it does not cover, and older segments are removed
+// once the manifest is on disk.
+type wal struct {
+	dir    string
+	policy SyncPolicy
+
+	mu    sync.Mutex
+	f     *os.File
+	seq   uint64
+	buf   []byte
+	dirty bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func segmentName(seq uint64) string {
+	return fmt.Sprintf("%020d%s", seq, walSegmentSuffix)
+}
+
+// segments returns the sequence numbers of the segments in dir in order.
+func segments(dir string) ([]uint64, error) {
+	fileInfoList, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var seqs []uint64
+	for _, fi := range fileInfoList {
+		if !strings.HasSuffix(fi.Name(), walSegmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(fi.Name(), walSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+// writeFileSync atomically replaces name with data and fsyncs it.
+func writeFileSync(name string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), name); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return syncDir(filepath.Dir(name))
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// openSegment starts segment seq and makes it the one appended to.
+// The caller holds w.mu.
+func (w *wal) openSegment(seq uint64) error {
+	f, err := os.OpenFile(filepath.Join(w.dir, segmentName(seq)), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(w.dir); err != nil {
+		f.Close()
+		return err
+	}
+	if w.f != nil {
+		if err := w.f.Sync(); err != nil {
+			f.Close()
+			return err
+		}
+		w.f.Close()
+	}
+	w.f, w.seq, w.dirty = f, seq, false
+	return nil
+}
+
+func (w *wal) append(rec *walRecord) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return errors.New("wal is closed")
+	}
+	w.buf = rec.marshal(w.buf)
+	_, err := w.f.Write(w.buf)
+	if cap(w.buf) > maxKeptBuffer {
+		w.buf = nil
+	}
+	if err != nil {
+		return err
+	}
+	if w.policy == SyncAlways {
+		return w.f.Sync()
+	}
+	w.dirty = true
+	return nil
+}
+
+// rotate starts a new segment and returns its sequence number. Records
+// appended before rotate are all in older segments.
+func (w *wal) rotate() (uint64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.openSegment(w.seq + 1); err != nil {
+		return 0, err
+	}
+	return w.seq, nil
+}
+
+// truncateWAL removes the segments in dir that come before segment seq.
+func truncateWAL(dir string, seq uint64) error {
+	seqs, err := segments(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, old := range seqs {
+		if old < seq {
+			if err := os.Remove(filepath.Join(dir, segmentName(old))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nextSegment returns the sequence number following the last segment in dir.
+func nextSegment(dir string) (uint64, error) {
+	seqs, err := segments(dir)
+	if os.IsNotExist(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(seqs) == 0 {
+		return 1, nil
+	}
+	return seqs[len(seqs)-1] + 1, nil
+}
+
+func (w *wal) sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil || !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	return w.f.Sync()
+}
+
+func (w *wal) syncEverySecond() {
+	defer close(w.done)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.sync(); err != nil {
+				log.Printf("failed to sync wal: %v", err)
+			}
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *wal) close() error {
+	if w.stop != nil {
+		close(w.stop)
+		<-w.done
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Sync()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	w.f = nil
+	return err
+}
+
+// OpenWAL logs every later mutation to the write-ahead log kept in dir, the
+// directory passed to Load and Dump. Load replays the log, so it must be
+// called on dir first; Dump truncates the log once a snapshot of dir is on
+// disk.
+func (s *cuckooFilterServer) OpenWAL(dir string, policy SyncPolicy) error {
+	walDir := filepath.Join(dir, walDirName)
+	if err := os.MkdirAll(walDir, os.ModePerm); err != nil {
+		return err
+	}
+	next, err := nextSegment(walDir)
+	if err != nil {
+		return err
+	}
+
+	w := &wal{dir: walDir, policy: policy}
+	if err := w.openSegment(next); err != nil {
+		return err
+	}
+	if policy == SyncEverySecond {
+		w.stop = make(chan struct{})
+		w.done = make(chan struct{})
+		go w.syncEverySecond()
+	}
+
+	s.persistMu.Lock()
+	s.wal = w
+	s.persistMu.Unlock()
+	return nil
+}
+
+// CloseWAL fsyncs and closes the write-ahead log. Later mutations are no
+// longer logged.
+func (s *cuckooFilterServer) CloseWAL() error {
+	s.persistMu.Lock()
+	w := s.wal
+	s.wal = nil
+	s.persistMu.Unlock()
+	if w == nil {
+		return nil
+	}
+	return w.close()
+}
+
+// replay applies the segments in dir from segment checkpoint on and returns
+// the number of records applied and how many of them failed to apply the way
+// they originally did. Replay of a segment stops at its first torn
+// or damaged record, which is what a crash in the middle of an append leaves
+// behind; the server never appends to a segment again after a restart, so
+// later segments are still replayed.
+func (s *cuckooFilterServer) replay(dir string, checkpoint uint64) (int, int, error) {
+	seqs, err := segments(dir)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var total, failed int
+	for _, seq := range seqs {
+		if seq < checkpoint {
+			continue
+		}
+		n, f, err := s.replaySegment(filepath.Join(dir, segmentName(seq)))
+		total += n
+		failed += f
+		if err == errCorruptRecord {
+			log.Printf("wal segment %d: stopped replay after %d records at a damaged record", seq, n)
+			continue
+		}
+		if err != nil {
+			return total, failed, err
+		}
+	}
+	return total, failed, nil
+}
+
+func (s *cuckooFilterServer) replaySegment(name string) (int, int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	var n, failed int
+	r := bufio.NewReader(f)
+	for {
+		rec, err := readRecord(r)
+		if err == io.EOF {
+			return n, failed, nil
+		}
+		if err != nil {
+			return n, failed, err
+		}
+		if !s.apply(rec) {
+			failed++
+		}
+		n++
+	}
+}
+
+// apply redoes a logged mutation and reports whether it did what the
+// original did. Records of filters that are not loaded, such as quarantined
+// ones, are skipped without being reported.
+func (s *cuckooFilterServer) apply(rec *walRecord) bool {
+	if rec.op == opCreateFilter {
+		s.filters.set(rec.name, createFilter(rec.config))
+		return true
+	}
+	if rec.op == opImportFilter {
+		f, err := decodeFilter(rec.data)
+		if err != nil {
+			log.Printf("wal: skipping import of filter %s: %v", rec.name, err)
+			return false
+		}
+		s.filters.set(rec.name, f)
+		return true
+	}
+	f, ok := s.filters.get(rec.name)
+	if !ok {
+		return true
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch rec.op {
+	case opDeleteFilter:
+		s.filters.remove(rec.name, f)
+	default:
+		if failed := f.cf.replay(rec); failed > 0 {
+			log.Printf("wal: %d of %d logged elements of filter %s failed to replay", failed, len(rec.elements)+len(rec.hashes), rec.name)
+			return false
+		}
+	}
+	return true
+}
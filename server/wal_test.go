@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// loggedServer returns a server logging to a temporary data directory, which
+// restart reloads it from. Its log is closed when the test ends.
+func loggedServer(t *testing.T) (*cuckooFilterServer, string) {
+	dir := t.TempDir()
+	s := NewServer()
+	assert.NoError(t, s.OpenWAL(dir, SyncNever))
+	t.Cleanup(func() { s.CloseWAL() })
+	return s, dir
+}
+
+func restart(t *testing.T, s *cuckooFilterServer, dir string) *cuckooFilterServer {
+	assert.NoError(t, s.CloseWAL())
+	s = NewServer()
+	_, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.NoError(t, s.OpenWAL(dir, SyncAlways))
+	t.Cleanup(func() { s.CloseWAL() })
+	return s
+}
+
+func found(s *cuckooFilterServer, filterName, element string) bool {
+	res, err := s.LookupElement(context.Background(), &pb.LookupElementRequest{FilterName: filterName, Element: element})
+	return err == nil && res.Found
+}
+
+func TestWALReplay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "wal")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := NewServer()
+	assert.NoError(t, s.OpenWAL(dir, SyncAlways))
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: []string{"mary", "rose"}})
+	s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "mary"})
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "bbb", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "bbb", Element: "jack"})
+	s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "bbb"})
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "ccc", Capacity: 100})
+	s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "ccc"})
+
+	s = restart(t, s, dir)
+
+	assert.ElementsMatch(t, []string{"aaa", "bbb"}, s.filters.names())
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "rose"))
+	assert.False(t, found(s, "aaa", "mary"))
+	assert.False(t, found(s, "bbb", "jack"))
+	assert.NoError(t, s.CloseWAL())
+}
+
+func TestWALTruncatedBySnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "wal")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := NewServer()
+	assert.NoError(t, s.OpenWAL(dir, SyncEverySecond))
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, s.Dump(dir))
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "mary"})
+
+	seqs, err := segments(filepath.Join(dir, walDirName))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2}, seqs)
+
+	s = restart(t, s, dir)
+
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "mary"))
+	res, _ := s.CountElements(ctx, &pb.CountElementsRequest{FilterName: "aaa"})
+	assert.Equal(t, uint64(2), res.Len)
+	assert.NoError(t, s.CloseWAL())
+}
+
+func TestWALTornRecord(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "wal")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := NewServer()
+	assert.NoError(t, s.OpenWAL(dir, SyncNever))
+	s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "jack"})
+	assert.NoError(t, s.CloseWAL())
+
+	// A crash in the middle of an append leaves half a record behind.
+	name := filepath.Join(dir, walDirName, segmentName(1))
+	rec := (&walRecord{op: opInsertElements, name: "aaa", elements: [][]byte{[]byte("mary")}}).marshal(nil)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.NoError(t, err)
+	f.Write(rec[:len(rec)-2])
+	f.Close()
+
+	s = NewServer()
+	_, err = s.Load(dir)
+	assert.NoError(t, err)
+	assert.NoError(t, s.OpenWAL(dir, SyncAlways))
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.False(t, found(s, "aaa", "mary"))
+
+	// Later segments are still replayed after the torn one.
+	s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "rose"})
+	s = restart(t, s, dir)
+	assert.True(t, found(s, "aaa", "jack"))
+	assert.True(t, found(s, "aaa", "rose"))
+	assert.NoError(t, s.CloseWAL())
+}
+
+func TestWALReplayFailed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	now := time.Now()
+	fakeClock(t, &now)
+
+	// The sweep that made room for the second batch is not logged, so on
+	// replay the expired first batch takes up the slots the second went to.
+	s, dir := loggedServer(t)
+	_, err := s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "aaa", Capacity: 100})
+	assert.NoError(t, err)
+	for _, batch := range []string{"old", "new"} {
+		elements := make([]string, 100)
+		for i := range elements {
+			elements[i] = batch + strconv.Itoa(i)
+		}
+		_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: elements, Ttl: durationpb.New(time.Minute)})
+		assert.NoError(t, err)
+		now = now.Add(2 * time.Minute)
+		s.sweep()
+	}
+	assert.NoError(t, s.CloseWAL())
+
+	s = NewServer()
+	report, err := s.Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Replayed)
+	assert.Equal(t, 1, report.ReplayFailed)
+}
+
+// breakWAL makes every append to the log of s fail.
+func breakWAL(t *testing.T, s *cuckooFilterServer) {
+	w := s.wal
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	f, err := os.Open(w.f.Name())
+	assert.NoError(t, err)
+	w.f.Close()
+	w.f = f
+}
+
+func TestUpdateRollsBackWhenLogFails(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	now := time.Now()
+	fakeClock(t, &now)
+
+	s, _ := loggedServer(t)
+	elements := make([]string, 90)
+	for i := range elements {
+		elements[i] = "old" + strconv.Itoa(i)
+	}
+	for _, req := range []*pb.CreateFilterRequest{
+		{FilterName: "aaa", Capacity: 100},
+		{FilterName: "bbb", Capacity: 100, Backend: "bloom"},
+		{FilterName: "ccc", Capacity: 100, Window: durationpb.New(time.Minute), WindowSlices: 2},
+	} {
+		_, err := s.CreateFilter(ctx, req)
+		assert.NoError(t, err)
+		_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: req.FilterName, Elements: elements[:40]})
+		assert.NoError(t, err)
+	}
+	_, err := s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: "aaa", Elements: elements[40:]})
+	assert.NoError(t, err)
+	snapshots := make(map[string][]byte)
+	for _, name := range s.filters.names() {
+		f, _ := s.filters.get(name)
+		_, snapshots[name] = f.snapshot(name)
+	}
+
+	breakWAL(t, s)
+	now = now.Add(time.Minute)
+	for _, name := range []string{"aaa", "bbb", "ccc"} {
+		_, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: name, Element: "new"})
+		assert.Equal(t, codes.Internal, status.Code(err), name)
+		assert.Equal(t, "LOG_FAILED", errorReason(err), name)
+		assert.False(t, found(s, name, "new"), name)
+		_, err = s.InsertElements(ctx, &pb.InsertElementsRequest{FilterName: name, Elements: []string{"new0", "new1", "new2", "new3", "new4", "new5", "new6", "new7", "new8", "new9", "new10", "new11"}})
+		assert.Equal(t, "LOG_FAILED", errorReason(err), name)
+	}
+	_, err = s.DeleteElement(ctx, &pb.DeleteElementRequest{FilterName: "aaa", Element: "old0"})
+	assert.Equal(t, "LOG_FAILED", errorReason(err))
+	_, err = s.ResetFilter(ctx, &pb.ResetFilterRequest{FilterName: "aaa"})
+	assert.Equal(t, "LOG_FAILED", errorReason(err))
+	_, err = s.DeleteFilter(ctx, &pb.DeleteFilterRequest{FilterName: "bbb"})
+	assert.Equal(t, "LOG_FAILED", errorReason(err))
+	_, err = s.CreateFilter(ctx, &pb.CreateFilterRequest{FilterName: "ddd", Capacity: 100})
+	assert.Equal(t, "LOG_FAILED", errorReason(err))
+	_, ok := s.filters.get("ddd")
+	assert.False(t, ok)
+	for name, want := range snapshots {
+		f, ok := s.filters.get(name)
+		assert.True(t, ok, name)
+		assert.False(t, f.deleted, name)
+		_, payload := f.snapshot(name)
+		assert.Equal(t, want, payload, name)
+	}
+
+	s.legacyStatus = true
+	res, err := s.InsertElement(ctx, &pb.InsertElementRequest{FilterName: "aaa", Element: "new"})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusLogFailed, res.Status)
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	for name, want := range map[string]SyncPolicy{"always": SyncAlways, "everysec": SyncEverySecond, "never": SyncNever} {
+		policy, err := ParseSyncPolicy(name)
+		assert.NoError(t, err)
+		assert.Equal(t, want, policy)
+	}
+	_, err := ParseSyncPolicy("sometimes")
+	assert.Error(t, err)
+}
@@ -0,0 +1,305 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	pb "github.com/guobinqiu/cuckoofilter/cuckoofilter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// defaultStaticFingerprintBits is the fingerprint size of static
+	// filters built without one.
+	defaultStaticFingerprintBits = 16
+	// maxStaticElements bounds the elements of a static filter, whose
+	// hashes the server holds in memory while building it.
+	maxStaticElements = 1 << 27
+	// maxXorAttempts bounds the seeds a build tries. A build succeeds with
+	// a seed with a probability of about 0.8 or more.
+	maxXorAttempts = 100
+)
+
+// staticFingerprintSizes are the fingerprint sizes of static filters.
+var staticFingerprintSizes = []uint{8, 16}
+
+// xorFilter is the static filter backend, registered as "xor": an xor
+// filter of 8 or 16 bit fingerprints, built once from the whole element set
+// by BuildStaticFilter. It takes about 1.23 times the fingerprint size per
+// element, never fails an insert because it takes none, and has a false
+// positive rate of 2^-bits.
+//
+// Elements are hashed like in cuckoo filters and the hashes remixed with
+// seed, so that a build can retry with another seed. An element maps to a
+// slot in each third of the table, and the fingerprints in its slots xor to
+// its own. The fingerprints are packed little endian into table.
+type xorFilter struct {
+	seed        uint64
+	bits        uint
+	blockLength uint32
+	table       []byte
+	count       uint
+}
+
+// splitmix64 returns the next seed of the sequence at state.
+func splitmix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+// remix mixes the hash of an element with seed.
+func remix(hash, seed uint64) uint64 {
+	h := hash + seed
+	h = (h ^ h>>33) * 0xff51afd7ed558ccd
+	h = (h ^ h>>33) * 0xc4ceb9fe1a85ec53
+	return h ^ h>>33
+}
+
+// reduce maps x onto [0, n) without a division.
+func reduce(x, n uint32) uint32 {
+	return uint32(uint64(x) * uint64(n) >> 32)
+}
+
+func (xf *xorFilter) slots(h uint64) [3]uint32 {
+	return [3]uint32{
+		reduce(uint32(h), xf.blockLength),
+		reduce(uint32(bits.RotateLeft64(h, 21)), xf.blockLength) + xf.blockLength,
+		reduce(uint32(bits.RotateLeft64(h, 42)), xf.blockLength) + 2*xf.blockLength,
+	}
+}
+
+func (xf *xorFilter) fingerprint(h uint64) uint16 {
+	return uint16(h^h>>32) & uint16(uint32(1)<<xf.bits-1)
+}
+
+func (xf *xorFilter) slot(i uint32) uint16 {
+	if xf.bits == 8 {
+		return uint16(xf.table[i])
+	}
+	return binary.LittleEndian.Uint16(xf.table[2*i:])
+}
+
+func (xf *xorFilter) setSlot(i uint32, fp uint16) {
+	if xf.bits == 8 {
+		xf.table[i] = byte(fp)
+	} else {
+		binary.LittleEndian.PutUint16(xf.table[2*i:], fp)
+	}
+}
+
+// newXorFilter returns an empty filter with room for n elements.
+func newXorFilter(n int, fingerprintBits uint) *xorFilter {
+	blockLength := (32 + uint32(math.Ceil(1.23*float64(n)))) / 3
+	return &xorFilter{
+		bits:        fingerprintBits,
+		blockLength: blockLength,
+		table:       make([]byte, 3*uint64(blockLength)*uint64(fingerprintBits/8)),
+	}
+}
+
+// buildXorFilter builds a filter holding the elements of the given hashes,
+// which it sorts. Repeated hashes are stored once.
+func buildXorFilter(hashes []uint64, fingerprintBits uint) (*xorFilter, error) {
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	n := 0
+	for i, h := range hashes {
+		if i == 0 || h != hashes[n-1] {
+			hashes[n] = h
+			n++
+		}
+	}
+	hashes = hashes[:n]
+
+	xf := newXorFilter(n, fingerprintBits)
+	xf.count = uint(n)
+	size := 3 * xf.blockLength
+	counts := make([]uint32, size)
+	masks := make([]uint64, size)
+	queue := make([]uint32, 0, size)
+	// peeled lists the mixed hashes in the order they were peeled off, next
+	// to the slot each was peeled off from.
+	type peel struct {
+		h    uint64
+		slot uint32
+	}
+	peeled := make([]peel, 0, n)
+	state := uint64(hashSeed)
+	for attempt := 0; attempt < maxXorAttempts; attempt++ {
+		xf.seed = splitmix64(&state)
+		for i := range counts {
+			counts[i], masks[i] = 0, 0
+		}
+		for _, hash := range hashes {
+			h := remix(hash, xf.seed)
+			for _, i := range xf.slots(h) {
+				counts[i]++
+				masks[i] ^= h
+			}
+		}
+		queue, peeled = queue[:0], peeled[:0]
+		for i, c := range counts {
+			if c == 1 {
+				queue = append(queue, uint32(i))
+			}
+		}
+		// Slots of a single element are peeled off until none are left.
+		for len(queue) > 0 {
+			i := queue[len(queue)-1]
+			queue = queue[:len(queue)-1]
+			if counts[i] != 1 {
+				continue
+			}
+			h := masks[i]
+			peeled = append(peeled, peel{h, i})
+			for _, j := range xf.slots(h) {
+				counts[j]--
+				masks[j] ^= h
+				if counts[j] == 1 {
+					queue = append(queue, j)
+				}
+			}
+		}
+		if len(peeled) != n {
+			continue
+		}
+		// Each element is assigned the slot it was peeled off from, whose
+		// other elements were all peeled off before it.
+		for k := len(peeled) - 1; k >= 0; k-- {
+			p := peeled[k]
+			fp := xf.fingerprint(p.h)
+			for _, i := range xf.slots(p.h) {
+				fp ^= xf.slot(i)
+			}
+			xf.setSlot(p.slot, fp)
+		}
+		return xf, nil
+	}
+	return nil, fmt.Errorf("failed to build a static filter of %d elements", n)
+}
+
+func decodeXorFilter(b []byte, fingerprintBits uint, seed uint64) (*xorFilter, error) {
+	if !supported(staticFingerprintSizes, fingerprintBits) {
+		return nil, fmt.Errorf("static filter has %d bit fingerprints", fingerprintBits)
+	}
+	blockBytes := 3 * uint64(fingerprintBits/8)
+	if len(b) == 0 || uint64(len(b))%blockBytes != 0 || uint64(len(b))/blockBytes > math.MaxUint32 {
+		return nil, errors.New("static filter data does not fill its table")
+	}
+	return &xorFilter{
+		seed:        seed,
+		bits:        fingerprintBits,
+		blockLength: uint32(uint64(len(b)) / blockBytes),
+		table:       b,
+	}, nil
+}
+
+// Insert fails: static filters are immutable.
+func (xf *xorFilter) Insert(data []byte) bool {
+	return false
+}
+
+func (xf *xorFilter) InsertUntil(data []byte, expiry uint32) bool {
+	return false
+}
+
+func (xf *xorFilter) InsertValue(data []byte, value uint16, expiry uint32) bool {
+	return false
+}
+
+func (xf *xorFilter) Value(data []byte) (uint16, bool) {
+	return 0, false
+}
+
+func (xf *xorFilter) Delete(data []byte) bool {
+	return false
+}
+
+func (xf *xorFilter) Lookup(data []byte) bool {
+	return xf.LookupHash(pb.HashElement(data))
+}
+
+func (xf *xorFilter) InsertHash(hash uint64, expiry uint32) bool {
+	return false
+}
+
+func (xf *xorFilter) LookupHash(hash uint64) bool {
+	h := remix(hash, xf.seed)
+	fp := xf.fingerprint(h)
+	for _, i := range xf.slots(h) {
+		fp ^= xf.slot(i)
+	}
+	return fp == 0
+}
+
+// Occurrences returns 1 if data may be in the set and 0 otherwise.
+func (xf *xorFilter) Occurrences(data []byte) uint {
+	if xf.Lookup(data) {
+		return 1
+	}
+	return 0
+}
+
+// Count returns the number of distinct elements the filter was built from.
+func (xf *xorFilter) Count() uint {
+	return xf.count
+}
+
+func (xf *xorFilter) Reset() {}
+
+func (xf *xorFilter) Sweep(now uint32) int {
+	return 0
+}
+
+func init() {
+	registerBackend("xor", &backendType{
+		check: func(config *filterConfig) *errdetails.BadRequest {
+			return badRequest("backend", "xor filters are built by BuildStaticFilter")
+		},
+		new: func(config filterConfig) backend {
+			bits, _ := config.layout()
+			return newXorFilter(0, bits)
+		},
+		decode: decodeXorSnapshot,
+	})
+}
+
+// supports reports that static filters only take hashes, for lookups.
+func (xf *xorFilter) supports(c capability) bool {
+	return c == canHash
+}
+
+func (xf *xorFilter) snapshot(h *snapshotHeader) []byte {
+	h.Count = xf.count
+	h.Seed = xf.seed
+	return xf.table
+}
+
+func decodeXorSnapshot(h *snapshotHeader, payload []byte) (backend, error) {
+	xf, err := decodeXorFilter(payload, h.Config.FingerprintBits, h.Seed)
+	if err != nil {
+		return nil, err
+	}
+	xf.count = h.Count
+	return xf, nil
+}
+
+func (xf *xorFilter) info(info *pb.FilterInfo) {
+	info.MemoryBytes = uint64(len(xf.table))
+	info.LoadFactor = float64(xf.count) / float64(3*xf.blockLength)
+	info.EstimatedFalsePositiveRate = math.Ldexp(1, -int(xf.bits))
+	info.FingerprintBits = uint32(xf.bits)
+}
+
+func (xf *xorFilter) annotate(rec *walRecord) {}
+
+// journal records nothing: static filters are never mutated.
+func (xf *xorFilter) journal(u *undoLog) {}
+
+// replay does nothing: static filters are logged whole when built.
+func (xf *xorFilter) replay(rec *walRecord) int { return 0 }